@@ -0,0 +1,181 @@
+package junos
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// reply of rpc without XMLName to accept single routing-engine result
+// and multi-routing-engine-results (chassis cluster) result.
+type alarmInformationReply struct {
+	Detail []alarmInformationDetail `xml:"alarm-detail"`
+	Item   []struct {
+		ReName string                   `xml:"re-name"`
+		Detail []alarmInformationDetail `xml:"alarm-information>alarm-detail"`
+	} `xml:"multi-routing-engine-item"`
+}
+type alarmInformationDetail struct {
+	Time struct {
+		Value   string `xml:",chardata"`
+		Seconds string `xml:"seconds,attr"`
+	} `xml:"alarm-time"`
+	Class            string `xml:"alarm-class"`
+	Description      string `xml:"alarm-description"`
+	ShortDescription string `xml:"alarm-short-description"`
+	Type             string `xml:"alarm-type"`
+}
+
+func dataSourceAlarms() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAlarmsRead,
+		Schema: map[string]*schema.Schema{
+			"major_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"minor_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"alarm": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"node": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"short_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time_seconds": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlarmsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	alarms, err := readAlarms("chassis", "<get-alarm-information/>", m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	systemAlarms, err := readAlarms("system", "<get-system-alarm-information/>", m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	alarms = append(alarms, systemAlarms...)
+	majorCount := 0
+	minorCount := 0
+	for _, alarm := range alarms {
+		switch strings.ToLower(alarm["class"].(string)) {
+		case "major":
+			majorCount++
+		case "minor":
+			minorCount++
+		}
+	}
+	d.SetId("alarms" + idSeparator + jnprSess.Hostname)
+	if tfErr := d.Set("major_count", majorCount); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("minor_count", minorCount); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("alarm", alarms); tfErr != nil {
+		panic(tfErr)
+	}
+
+	return nil
+}
+
+func readAlarms(source, rpc string, m interface{}, jnprSess *NetconfObject) ([]map[string]interface{}, error) {
+	sess := m.(*Session)
+	alarms := make([]map[string]interface{}, 0)
+	reply, err := sess.commandXML(rpc, jnprSess)
+	if err != nil {
+		return alarms, err
+	}
+	var alarmInfo alarmInformationReply
+	if err := xml.Unmarshal([]byte(reply), &alarmInfo); err != nil {
+		return alarms, fmt.Errorf("failed to xml unmarshal reply : %w", err)
+	}
+	for _, detail := range alarmInfo.Detail {
+		alarm, err := genAlarmsDetail(source, "", detail)
+		if err != nil {
+			return alarms, err
+		}
+		alarms = append(alarms, alarm)
+	}
+	for _, item := range alarmInfo.Item {
+		for _, detail := range item.Detail {
+			alarm, err := genAlarmsDetail(source, strings.TrimSpace(item.ReName), detail)
+			if err != nil {
+				return alarms, err
+			}
+			alarms = append(alarms, alarm)
+		}
+	}
+
+	return alarms, nil
+}
+
+func genAlarmsDetail(source, node string, detail alarmInformationDetail) (map[string]interface{}, error) {
+	alarm := map[string]interface{}{
+		"source":            source,
+		"node":              node,
+		"class":             strings.TrimSpace(detail.Class),
+		"description":       strings.TrimSpace(detail.Description),
+		"short_description": strings.TrimSpace(detail.ShortDescription),
+		"type":              strings.TrimSpace(detail.Type),
+		"time":              strings.TrimSpace(detail.Time.Value),
+		"time_seconds":      0,
+	}
+	if strings.TrimSpace(detail.Time.Seconds) != "" {
+		var err error
+		alarm["time_seconds"], err = strconv.Atoi(strings.TrimSpace(detail.Time.Seconds))
+		if err != nil {
+			return alarm, fmt.Errorf("failed to convert value from '%s' to integer : %w", detail.Time.Seconds, err)
+		}
+	}
+
+	return alarm, nil
+}
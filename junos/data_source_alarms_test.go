@@ -0,0 +1,36 @@
+package junos_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAlarms_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceAlarmsConfig(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet("data.junos_alarms.testacc_dataalarms",
+							"major_count"),
+						resource.TestCheckResourceAttrSet("data.junos_alarms.testacc_dataalarms",
+							"minor_count"),
+						resource.TestCheckResourceAttrSet("data.junos_alarms.testacc_dataalarms",
+							"alarm.#"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func testAccDataSourceAlarmsConfig() string {
+	return `
+data junos_alarms testacc_dataalarms {}
+`
+}
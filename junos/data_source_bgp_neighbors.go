@@ -0,0 +1,258 @@
+package junos
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type bgpInformation struct {
+	XMLName xml.Name             `xml:"bgp-information"`
+	Peer    []bgpInformationPeer `xml:"bgp-peer"`
+}
+type bgpInformationPeer struct {
+	PeerAddress     string              `xml:"peer-address"`
+	PeerAs          string              `xml:"peer-as"`
+	LocalAddress    string              `xml:"local-address"`
+	LocalAs         string              `xml:"local-as"`
+	PeerGroup       string              `xml:"peer-group"`
+	PeerCfgRti      string              `xml:"peer-cfg-rti"`
+	PeerState       string              `xml:"peer-state"`
+	PeerType        string              `xml:"peer-type"`
+	FlapCount       string              `xml:"flap-count"`
+	LastFlapEvent   string              `xml:"last-flap-event"`
+	PeerDescription string              `xml:"description"`
+	Rib             []bgpInformationRib `xml:"bgp-rib"`
+}
+type bgpInformationRib struct {
+	Name                  string `xml:"name"`
+	ActivePrefixCount     string `xml:"active-prefix-count"`
+	ReceivedPrefixCount   string `xml:"received-prefix-count"`
+	AcceptedPrefixCount   string `xml:"accepted-prefix-count"`
+	AdvertisedPrefixCount string `xml:"advertised-prefix-count"`
+}
+
+func dataSourceBgpNeighbors() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBgpNeighborsRead,
+		Schema: map[string]*schema.Schema{
+			"routing_instance": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          defaultWord,
+				ValidateDiagFunc: validateNameObjectJunos([]string{}),
+			},
+			"group": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"neighbor_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"neighbor": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"peer_as": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"local_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"local_as": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"routing_instance": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"flap_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"last_flap_event": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"received_prefix_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"accepted_prefix_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"rib": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"active_prefix_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"received_prefix_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"accepted_prefix_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"advertised_prefix_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBgpNeighborsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	neighbors, err := readBgpNeighbors(d.Get("routing_instance").(string), d.Get("group").(string),
+		d.Get("neighbor_address").(string), m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("bgp_neighbors" + idSeparator + d.Get("routing_instance").(string) + idSeparator +
+		d.Get("group").(string) + idSeparator + d.Get("neighbor_address").(string))
+	if tfErr := d.Set("neighbor", neighbors); tfErr != nil {
+		panic(tfErr)
+	}
+
+	return nil
+}
+
+func readBgpNeighbors(instance, group, neighborAddress string,
+	m interface{}, jnprSess *NetconfObject) ([]map[string]interface{}, error) {
+	sess := m.(*Session)
+	neighbors := make([]map[string]interface{}, 0)
+	rpcBgpNeighbor := "<get-bgp-neighbor-information>"
+	// without instance, rpc return neighbors of all instances
+	if instance == defaultWord {
+		rpcBgpNeighbor += "<instance>master</instance>"
+	} else {
+		rpcBgpNeighbor += "<instance>" + instance + "</instance>"
+	}
+	if neighborAddress != "" {
+		rpcBgpNeighbor += "<neighbor-address>" + neighborAddress + "</neighbor-address>"
+	}
+	rpcBgpNeighbor += "</get-bgp-neighbor-information>"
+	reply, err := sess.commandXML(rpcBgpNeighbor, jnprSess)
+	if err != nil {
+		if strings.Contains(err.Error(), "BGP is not running") {
+			return neighbors, nil
+		}
+
+		return neighbors, err
+	}
+	var bgpInfo bgpInformation
+	if err := xml.Unmarshal([]byte(reply), &bgpInfo); err != nil {
+		return neighbors, fmt.Errorf("failed to xml unmarshal reply : %w", err)
+	}
+	for _, peer := range bgpInfo.Peer {
+		if group != "" && strings.TrimSpace(peer.PeerGroup) != group {
+			continue
+		}
+		neighbor := map[string]interface{}{
+			"address":               strings.Split(strings.TrimSpace(peer.PeerAddress), "+")[0],
+			"peer_as":               strings.TrimSpace(peer.PeerAs),
+			"local_address":         strings.Split(strings.TrimSpace(peer.LocalAddress), "+")[0],
+			"local_as":              strings.TrimSpace(peer.LocalAs),
+			"group":                 strings.TrimSpace(peer.PeerGroup),
+			"routing_instance":      strings.TrimSpace(peer.PeerCfgRti),
+			"description":           strings.TrimSpace(peer.PeerDescription),
+			"state":                 strings.TrimSpace(peer.PeerState),
+			"type":                  strings.TrimSpace(peer.PeerType),
+			"flap_count":            0,
+			"last_flap_event":       strings.TrimSpace(peer.LastFlapEvent),
+			"received_prefix_count": 0,
+			"accepted_prefix_count": 0,
+			"rib":                   make([]map[string]interface{}, 0),
+		}
+		if strings.TrimSpace(peer.FlapCount) != "" {
+			neighbor["flap_count"], err = strconv.Atoi(strings.TrimSpace(peer.FlapCount))
+			if err != nil {
+				return neighbors, fmt.Errorf("failed to convert value from '%s' to integer : %w", peer.FlapCount, err)
+			}
+		}
+		for _, rib := range peer.Rib {
+			ribCount := map[string]interface{}{
+				"name":                    strings.TrimSpace(rib.Name),
+				"active_prefix_count":     0,
+				"received_prefix_count":   0,
+				"accepted_prefix_count":   0,
+				"advertised_prefix_count": 0,
+			}
+			for k, v := range map[string]string{
+				"active_prefix_count":     rib.ActivePrefixCount,
+				"received_prefix_count":   rib.ReceivedPrefixCount,
+				"accepted_prefix_count":   rib.AcceptedPrefixCount,
+				"advertised_prefix_count": rib.AdvertisedPrefixCount,
+			} {
+				if strings.TrimSpace(v) == "" {
+					continue
+				}
+				ribCount[k], err = strconv.Atoi(strings.TrimSpace(v))
+				if err != nil {
+					return neighbors, fmt.Errorf("failed to convert value from '%s' to integer : %w", v, err)
+				}
+			}
+			neighbor["received_prefix_count"] = neighbor["received_prefix_count"].(int) +
+				ribCount["received_prefix_count"].(int)
+			neighbor["accepted_prefix_count"] = neighbor["accepted_prefix_count"].(int) +
+				ribCount["accepted_prefix_count"].(int)
+			neighbor["rib"] = append(neighbor["rib"].([]map[string]interface{}), ribCount)
+		}
+		neighbors = append(neighbors, neighbor)
+	}
+
+	return neighbors, nil
+}
@@ -0,0 +1,83 @@
+package junos_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceBgpNeighbors_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceBgpNeighborsConfigCreate(),
+				},
+				{
+					Config: testAccDataSourceBgpNeighborsConfigData(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.junos_bgp_neighbors.testacc_databgpneighbors",
+							"neighbor.#", "1"),
+						resource.TestCheckResourceAttr("data.junos_bgp_neighbors.testacc_databgpneighbors",
+							"neighbor.0.address", "192.0.2.4"),
+						resource.TestCheckResourceAttr("data.junos_bgp_neighbors.testacc_databgpneighbors",
+							"neighbor.0.peer_as", "65001"),
+						resource.TestCheckResourceAttr("data.junos_bgp_neighbors.testacc_databgpneighbors",
+							"neighbor.0.group", "testacc_databgpneighbors"),
+						resource.TestCheckResourceAttr("data.junos_bgp_neighbors.testacc_databgpneighbors",
+							"neighbor.0.routing_instance", "testacc_databgpneighbors"),
+						resource.TestCheckResourceAttr("data.junos_bgp_neighbors.testacc_databgpneighbors",
+							"neighbor.0.received_prefix_count", "0"),
+					),
+				},
+			},
+			PreventPostDestroyRefresh: true,
+		})
+	}
+}
+
+func testAccDataSourceBgpNeighborsConfigCreate() string {
+	return `
+resource junos_routing_instance testacc_databgpneighbors {
+  name = "testacc_databgpneighbors"
+  as   = "65000"
+}
+resource junos_bgp_group testacc_databgpneighbors {
+  name             = "testacc_databgpneighbors"
+  routing_instance = junos_routing_instance.testacc_databgpneighbors.name
+  peer_as          = "65001"
+}
+resource junos_bgp_neighbor testacc_databgpneighbors {
+  ip               = "192.0.2.4"
+  routing_instance = junos_routing_instance.testacc_databgpneighbors.name
+  group            = junos_bgp_group.testacc_databgpneighbors.name
+}
+`
+}
+
+func testAccDataSourceBgpNeighborsConfigData() string {
+	return `
+resource junos_routing_instance testacc_databgpneighbors {
+  name = "testacc_databgpneighbors"
+  as   = "65000"
+}
+resource junos_bgp_group testacc_databgpneighbors {
+  name             = "testacc_databgpneighbors"
+  routing_instance = junos_routing_instance.testacc_databgpneighbors.name
+  peer_as          = "65001"
+}
+resource junos_bgp_neighbor testacc_databgpneighbors {
+  ip               = "192.0.2.4"
+  routing_instance = junos_routing_instance.testacc_databgpneighbors.name
+  group            = junos_bgp_group.testacc_databgpneighbors.name
+}
+
+data junos_bgp_neighbors testacc_databgpneighbors {
+  routing_instance = junos_routing_instance.testacc_databgpneighbors.name
+  group            = junos_bgp_group.testacc_databgpneighbors.name
+}
+`
+}
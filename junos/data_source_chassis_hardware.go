@@ -0,0 +1,171 @@
+package junos
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type chassisInventoryModule struct {
+	Name            string                   `xml:"name"`
+	Version         string                   `xml:"version"`
+	PartNumber      string                   `xml:"part-number"`
+	SerialNumber    string                   `xml:"serial-number"`
+	Description     string                   `xml:"description"`
+	ModelNumber     string                   `xml:"model-number"`
+	SubModule       []chassisInventoryModule `xml:"chassis-sub-module"`
+	SubSubModule    []chassisInventoryModule `xml:"chassis-sub-sub-module"`
+	SubSubSubModule []chassisInventoryModule `xml:"chassis-sub-sub-sub-module"`
+}
+
+func dataSourceChassisHardware() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceChassisHardwareRead,
+		Schema: map[string]*schema.Schema{
+			"chassis": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"serial_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"module": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"part_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"serial_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"model_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceChassisHardwareRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	chassisList, modules, err := readChassisHardware(m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("chassis_hardware" + idSeparator + jnprSess.Hostname)
+	if tfErr := d.Set("chassis", chassisList); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("module", modules); tfErr != nil {
+		panic(tfErr)
+	}
+
+	return nil
+}
+
+func readChassisHardware(m interface{}, jnprSess *NetconfObject) (
+	[]map[string]interface{}, []map[string]interface{}, error) {
+	sess := m.(*Session)
+	chassisList := make([]map[string]interface{}, 0)
+	modules := make([]map[string]interface{}, 0)
+	reply, err := sess.commandXML("<get-chassis-inventory/>", jnprSess)
+	if err != nil {
+		return chassisList, modules, err
+	}
+	var inventory chassisInventoryReply
+	if err := xml.Unmarshal([]byte(reply), &inventory); err != nil {
+		return chassisList, modules, fmt.Errorf("failed to xml unmarshal reply : %w", err)
+	}
+	for _, chassis := range inventory.Chassis {
+		chassisList = append(chassisList, genChassisHardwareChassis("", chassis))
+		modules = append(modules, genChassisHardwareModules("", "", chassis.Module)...)
+	}
+	for _, item := range inventory.Item {
+		for _, chassis := range item.Chassis {
+			chassisList = append(chassisList, genChassisHardwareChassis(strings.TrimSpace(item.ReName), chassis))
+			modules = append(modules, genChassisHardwareModules(strings.TrimSpace(item.ReName), "", chassis.Module)...)
+		}
+	}
+
+	return chassisList, modules, nil
+}
+
+func genChassisHardwareChassis(node string, chassis chassisInventoryChassis) map[string]interface{} {
+	return map[string]interface{}{
+		"node":          node,
+		"serial_number": strings.TrimSpace(chassis.SerialNumber),
+		"description":   strings.TrimSpace(chassis.Description),
+	}
+}
+
+func genChassisHardwareModules(node, parent string, modules []chassisInventoryModule) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(modules))
+	for _, module := range modules {
+		name := strings.TrimSpace(module.Name)
+		result = append(result, map[string]interface{}{
+			"node":          node,
+			"name":          name,
+			"parent":        parent,
+			"version":       strings.TrimSpace(module.Version),
+			"part_number":   strings.TrimSpace(module.PartNumber),
+			"serial_number": strings.TrimSpace(module.SerialNumber),
+			"description":   strings.TrimSpace(module.Description),
+			"model_number":  strings.TrimSpace(module.ModelNumber),
+		})
+		// each level of sub-module has its own xml tag
+		result = append(result, genChassisHardwareModules(node, name, module.SubModule)...)
+		result = append(result, genChassisHardwareModules(node, name, module.SubSubModule)...)
+		result = append(result, genChassisHardwareModules(node, name, module.SubSubSubModule)...)
+	}
+
+	return result
+}
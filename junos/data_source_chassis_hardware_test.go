@@ -0,0 +1,34 @@
+package junos_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceChassisHardware_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceChassisHardwareConfig(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.junos_chassis_hardware.testacc_datachassishw",
+							"chassis.#", "1"),
+						resource.TestCheckResourceAttrSet("data.junos_chassis_hardware.testacc_datachassishw",
+							"chassis.0.description"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func testAccDataSourceChassisHardwareConfig() string {
+	return `
+data junos_chassis_hardware testacc_datachassishw {}
+`
+}
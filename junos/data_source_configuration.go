@@ -0,0 +1,94 @@
+package junos
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type configurationTextOutput struct {
+	XMLName xml.Name `xml:"configuration-output"`
+	Text    string   `xml:",chardata"`
+}
+
+func dataSourceConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceConfigurationRead,
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringDoesNotContainAny("|<>&"),
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "text",
+				ValidateFunc: validation.StringInSlice([]string{"set", "text", "xml", "json"}, false),
+			},
+			"content": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceConfigurationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	content, err := readConfiguration(d.Get("path").(string), d.Get("format").(string), m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("configuration" + idSeparator + d.Get("format").(string) + idSeparator + d.Get("path").(string))
+	if tfErr := d.Set("content", content); tfErr != nil {
+		panic(tfErr)
+	}
+
+	return nil
+}
+
+func readConfiguration(path, format string, m interface{}, jnprSess *NetconfObject) (string, error) {
+	sess := m.(*Session)
+	showCommand := strings.TrimSpace("show configuration " + path)
+	rpcFormat := format
+	if format == "set" {
+		rpcFormat = "text"
+		showCommand += " | display set"
+	}
+	rpc := "<command format=\"" + rpcFormat + "\">" + showCommand + "</command>"
+	reply, err := sess.commandXML(rpc, jnprSess)
+	if err != nil {
+		return "", err
+	}
+	if rpcFormat == "text" {
+		if strings.TrimSpace(reply) == "" {
+			return "", nil
+		}
+		var output configurationTextOutput
+		if err := xml.Unmarshal([]byte(reply), &output); err != nil {
+			return "", fmt.Errorf("failed to xml unmarshal reply : %w", err)
+		}
+
+		return strings.TrimSpace(output.Text), nil
+	}
+
+	if rpcFormat == "json" {
+		// json output is not in xml element, unescape it directly
+		return strings.TrimSpace(html.UnescapeString(reply)), nil
+	}
+
+	return strings.TrimSpace(reply), nil
+}
@@ -0,0 +1,90 @@
+package junos_test
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// export TESTACC_INTERFACE=<inteface> for choose interface available else it's ge-0/0/3.
+func TestAccDataSourceConfiguration_basic(t *testing.T) {
+	var testaccInterface string
+	if os.Getenv("TESTACC_INTERFACE") != "" {
+		testaccInterface = os.Getenv("TESTACC_INTERFACE")
+	} else {
+		testaccInterface = defaultInterfaceTestAcc
+	}
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceConfigurationConfigCreate(testaccInterface),
+				},
+				{
+					Config: testAccDataSourceConfigurationConfigData(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.junos_configuration.testacc_dataconfig_set",
+							"content", "set routing-instances testacc_dataconfig instance-type virtual-router"),
+						resource.TestMatchResourceAttr("data.junos_configuration.testacc_dataconfig_text",
+							"content", regexp.MustCompile(`instance-type virtual-router;`)),
+						resource.TestMatchResourceAttr("data.junos_configuration.testacc_dataconfig_json",
+							"content", regexp.MustCompile(`"virtual-router"`)),
+						resource.TestMatchResourceAttr("data.junos_configuration.testacc_dataconfig_json_escape",
+							"content", regexp.MustCompile(`"testacc_dataconfig & <json>"`)),
+						resource.TestMatchResourceAttr("data.junos_configuration.testacc_dataconfig_xml_escape",
+							"content", regexp.MustCompile(`<description>testacc_dataconfig &amp; &lt;json(>|&gt;)</description>`)),
+					),
+				},
+			},
+			PreventPostDestroyRefresh: true,
+		})
+	}
+}
+
+func testAccDataSourceConfigurationConfigCreate(interFace string) string {
+	return `
+resource junos_routing_instance testacc_dataconfig {
+  name = "testacc_dataconfig"
+}
+resource junos_interface testacc_dataconfig {
+  name        = "` + interFace + `"
+  description = "testacc_dataconfig & <json>"
+}
+`
+}
+
+func testAccDataSourceConfigurationConfigData(interFace string) string {
+	return `
+resource junos_routing_instance testacc_dataconfig {
+  name = "testacc_dataconfig"
+}
+resource junos_interface testacc_dataconfig {
+  name        = "` + interFace + `"
+  description = "testacc_dataconfig & <json>"
+}
+
+data junos_configuration testacc_dataconfig_set {
+  path   = "routing-instances ${junos_routing_instance.testacc_dataconfig.name}"
+  format = "set"
+}
+data junos_configuration testacc_dataconfig_text {
+  path = "routing-instances ${junos_routing_instance.testacc_dataconfig.name}"
+}
+data junos_configuration testacc_dataconfig_json {
+  path   = "routing-instances ${junos_routing_instance.testacc_dataconfig.name}"
+  format = "json"
+}
+data junos_configuration testacc_dataconfig_json_escape {
+  path   = "interfaces ${junos_interface.testacc_dataconfig.name}"
+  format = "json"
+}
+data junos_configuration testacc_dataconfig_xml_escape {
+  path   = "interfaces ${junos_interface.testacc_dataconfig.name}"
+  format = "xml"
+}
+`
+}
@@ -2,7 +2,9 @@ package junos
 
 import (
 	"context"
+	"encoding/xml"
 	"fmt"
+	"html"
 	"regexp"
 	"strings"
 
@@ -10,6 +12,31 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+type interfaceTerseInformation struct {
+	XMLName           xml.Name                 `xml:"interface-information"`
+	PhysicalInterface []interfaceTersePhysical `xml:"physical-interface"`
+	LogicalInterface  []interfaceTerseLogical  `xml:"logical-interface"`
+}
+type interfaceTersePhysical struct {
+	Name             string                  `xml:"name"`
+	AdminStatus      string                  `xml:"admin-status"`
+	OperStatus       string                  `xml:"oper-status"`
+	Description      string                  `xml:"description"`
+	LogicalInterface []interfaceTerseLogical `xml:"logical-interface"`
+}
+type interfaceTerseLogical struct {
+	Name          string                        `xml:"name"`
+	AdminStatus   string                        `xml:"admin-status"`
+	OperStatus    string                        `xml:"oper-status"`
+	Description   string                        `xml:"description"`
+	AddressFamily []interfaceTerseAddressFamily `xml:"address-family"`
+}
+type interfaceTerseAddressFamily struct {
+	Name             string   `xml:"address-family-name"`
+	AeBundleName     string   `xml:"ae-bundle-name"`
+	InterfaceAddress []string `xml:"interface-address>ifa-local"`
+}
+
 func dataSourceInterface() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceInterfaceRead,
@@ -31,6 +58,19 @@ func dataSourceInterface() *schema.Resource {
 					return
 				},
 			},
+			"match_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if _, err := regexp.Compile(value); err != nil {
+						errors = append(errors, fmt.Errorf(
+							"%q for %q is not valid regexp", value, k))
+					}
+
+					return
+				},
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -310,13 +350,23 @@ func dataSourceInterface() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"admin_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"oper_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
 func dataSourceInterfaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	if d.Get("config_interface").(string) == "" && d.Get("match").(string) == "" {
-		return diag.FromErr(fmt.Errorf("no arguments provided, 'config_interface' and 'match' empty"))
+	if d.Get("config_interface").(string) == "" && d.Get("match").(string) == "" &&
+		d.Get("match_description").(string) == "" {
+		return diag.FromErr(fmt.Errorf("no arguments provided, " +
+			"'config_interface', 'match' and 'match_description' empty"))
 	}
 	sess := m.(*Session)
 	jnprSess, err := sess.startNewSession()
@@ -324,7 +374,8 @@ func dataSourceInterfaceRead(ctx context.Context, d *schema.ResourceData, m inte
 		return diag.FromErr(err)
 	}
 	defer sess.closeSession(jnprSess)
-	nameFound, err := searchInterfaceID(d.Get("config_interface").(string), d.Get("match").(string), m, jnprSess)
+	nameFound, err := searchInterfaceID(d.Get("config_interface").(string), d.Get("match").(string),
+		d.Get("match_description").(string), m, jnprSess)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -335,19 +386,30 @@ func dataSourceInterfaceRead(ctx context.Context, d *schema.ResourceData, m inte
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	adminStatus, operStatus, err := readInterfaceStatus(nameFound, m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	d.SetId(nameFound)
 	if tfErr := d.Set("name", nameFound); tfErr != nil {
 		panic(tfErr)
 	}
 	fillInterfaceData(d, interfaceOpt)
+	if tfErr := d.Set("admin_status", adminStatus); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("oper_status", operStatus); tfErr != nil {
+		panic(tfErr)
+	}
 
 	return nil
 }
 
-func searchInterfaceID(configInterface string, match string,
+func searchInterfaceID(configInterface string, match string, matchDescription string,
 	m interface{}, jnprSess *NetconfObject) (string, error) {
 	sess := m.(*Session)
 	intConfigList := make([]string, 0)
+	intDescriptionList := make([]string, 0)
 	intConfig, err := sess.command("show configuration interfaces "+configInterface+" | display set", jnprSess)
 	if err != nil {
 		return "", err
@@ -363,6 +425,18 @@ func searchInterfaceID(configInterface string, match string,
 			continue
 		}
 		itemTrim := strings.TrimPrefix(item, "set interfaces ")
+		if matchDescription != "" {
+			intDescription, description := interfaceDescriptionFromLine(itemTrim)
+			if intDescription != "" {
+				matched, err := regexp.MatchString(matchDescription, description)
+				if err != nil {
+					return "", fmt.Errorf("failed to regexp with %s : %w", matchDescription, err)
+				}
+				if matched {
+					intDescriptionList = append(intDescriptionList, intDescription)
+				}
+			}
+		}
 		matched, err := regexp.MatchString(match, itemTrim)
 		if err != nil {
 			return "", fmt.Errorf("failed to regexp with %s : %w", match, err)
@@ -387,6 +461,15 @@ func searchInterfaceID(configInterface string, match string,
 		}
 	}
 	intConfigList = uniqueListString(intConfigList)
+	if matchDescription != "" {
+		intConfigListDesc := make([]string, 0)
+		for _, v := range intConfigList {
+			if stringInSlice(v, intDescriptionList) {
+				intConfigListDesc = append(intConfigListDesc, v)
+			}
+		}
+		intConfigList = intConfigListDesc
+	}
 	if len(intConfigList) == 0 {
 		return "", nil
 	}
@@ -396,3 +479,94 @@ func searchInterfaceID(configInterface string, match string,
 
 	return intConfigList[0], nil
 }
+
+// interfaceDescriptionFromLine return interface name and description if line (without 'set interfaces ')
+// is a description line.
+func interfaceDescriptionFromLine(itemTrim string) (string, string) {
+	itemTrimSplit := strings.Split(itemTrim, " ")
+	switch {
+	case len(itemTrimSplit) > 2 && itemTrimSplit[1] == "description":
+		return itemTrimSplit[0], strings.Trim(strings.Join(itemTrimSplit[2:], " "), "\"")
+	case len(itemTrimSplit) > 4 && itemTrimSplit[1] == "unit" && itemTrimSplit[3] == "description":
+		return itemTrimSplit[0] + "." + itemTrimSplit[2], strings.Trim(strings.Join(itemTrimSplit[4:], " "), "\"")
+	}
+
+	return "", ""
+}
+
+func readInterfaceTerse(interFace string, m interface{}, jnprSess *NetconfObject) (
+	interfaceTerseInformation, error) {
+	sess := m.(*Session)
+	var terse interfaceTerseInformation
+	rpcIntTerse := "<get-interface-information><terse/></get-interface-information>"
+	if interFace != "" {
+		rpcIntTerse = "<get-interface-information><interface-name>" + html.EscapeString(interFace) +
+			"</interface-name><terse/></get-interface-information>"
+	}
+	reply, err := sess.commandXML(rpcIntTerse, jnprSess)
+	if err != nil {
+		// interface can be configured but not present on device (missing PIC, unused ae, ...)
+		if interFace != "" && strings.Contains(err.Error(), " not found") {
+			return terse, nil
+		}
+
+		return terse, err
+	}
+	if err := xml.Unmarshal([]byte(reply), &terse); err != nil {
+		return terse, fmt.Errorf("failed to xml unmarshal reply : %w", err)
+	}
+
+	return terse, nil
+}
+
+// readInterfaceDescriptions return descriptions of interfaces (terse rpc doesn't return it).
+func readInterfaceDescriptions(m interface{}, jnprSess *NetconfObject) (map[string]string, error) {
+	sess := m.(*Session)
+	descriptions := make(map[string]string)
+	reply, err := sess.commandXML("<get-interface-information><descriptions/></get-interface-information>", jnprSess)
+	if err != nil {
+		return descriptions, err
+	}
+	if strings.TrimSpace(reply) == "" {
+		return descriptions, nil
+	}
+	var descriptionsInfo interfaceTerseInformation
+	if err := xml.Unmarshal([]byte(reply), &descriptionsInfo); err != nil {
+		return descriptions, fmt.Errorf("failed to xml unmarshal reply : %w", err)
+	}
+	for _, physical := range descriptionsInfo.PhysicalInterface {
+		descriptions[strings.TrimSpace(physical.Name)] = strings.TrimSpace(physical.Description)
+		for _, logical := range physical.LogicalInterface {
+			descriptions[strings.TrimSpace(logical.Name)] = strings.TrimSpace(logical.Description)
+		}
+	}
+	for _, logical := range descriptionsInfo.LogicalInterface {
+		descriptions[strings.TrimSpace(logical.Name)] = strings.TrimSpace(logical.Description)
+	}
+
+	return descriptions, nil
+}
+
+func readInterfaceStatus(interFace string, m interface{}, jnprSess *NetconfObject) (string, string, error) {
+	terse, err := readInterfaceTerse(interFace, m, jnprSess)
+	if err != nil {
+		return "", "", err
+	}
+	for _, logical := range terse.LogicalInterface {
+		if strings.TrimSpace(logical.Name) == interFace {
+			return strings.TrimSpace(logical.AdminStatus), strings.TrimSpace(logical.OperStatus), nil
+		}
+	}
+	for _, physical := range terse.PhysicalInterface {
+		if strings.TrimSpace(physical.Name) == interFace {
+			return strings.TrimSpace(physical.AdminStatus), strings.TrimSpace(physical.OperStatus), nil
+		}
+		for _, logical := range physical.LogicalInterface {
+			if strings.TrimSpace(logical.Name) == interFace {
+				return strings.TrimSpace(logical.AdminStatus), strings.TrimSpace(logical.OperStatus), nil
+			}
+		}
+	}
+
+	return "", "", nil
+}
@@ -0,0 +1,283 @@
+package junos
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type interfaceStatisticsInformation struct {
+	XMLName           xml.Name                    `xml:"interface-information"`
+	PhysicalInterface []interfaceStatisticsDetail `xml:"physical-interface"`
+	LogicalInterface  []interfaceStatisticsDetail `xml:"logical-interface"`
+}
+type interfaceStatisticsDetail struct {
+	Name              string `xml:"name"`
+	AdminStatus       string `xml:"admin-status"`
+	OperStatus        string `xml:"oper-status"`
+	Speed             string `xml:"speed"`
+	TrafficStatistics struct {
+		InputBytes    string `xml:"input-bytes"`
+		InputBps      string `xml:"input-bps"`
+		OutputBytes   string `xml:"output-bytes"`
+		OutputBps     string `xml:"output-bps"`
+		InputPackets  string `xml:"input-packets"`
+		InputPps      string `xml:"input-pps"`
+		OutputPackets string `xml:"output-packets"`
+		OutputPps     string `xml:"output-pps"`
+	} `xml:"traffic-statistics"`
+	InputErrorList struct {
+		InputErrors     string `xml:"input-errors"`
+		InputDrops      string `xml:"input-drops"`
+		InputDiscards   string `xml:"input-discards"`
+		InputFifoErrors string `xml:"input-fifo-errors"`
+	} `xml:"input-error-list"`
+	OutputErrorList struct {
+		CarrierTransitions string `xml:"carrier-transitions"`
+		OutputErrors       string `xml:"output-errors"`
+		OutputCollisions   string `xml:"output-collisions"`
+		OutputDrops        string `xml:"output-drops"`
+		OutputFifoErrors   string `xml:"output-fifo-errors"`
+	} `xml:"output-error-list"`
+	Queue []struct {
+		Number              string `xml:"queue-number"`
+		ForwardingClassName string `xml:"forwarding-class-name"`
+		QueuedPackets       string `xml:"queued-packets"`
+		TransmittedPackets  string `xml:"transmitted-packets"`
+		DropPackets         string `xml:"drop-packets"`
+	} `xml:"queue-counters>queue"`
+}
+
+func dataSourceInterfaceStatistics() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceInterfaceStatisticsRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"admin_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"oper_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"speed": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"input_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"input_bps": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"input_packets": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"input_pps": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"output_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"output_bps": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"output_packets": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"output_pps": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"input_errors": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"input_drops": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"input_discards": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"input_fifo_errors": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"carrier_transitions": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"output_errors": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"output_collisions": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"output_drops": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"output_fifo_errors": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"queue": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"forwarding_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"queued_packets": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"transmitted_packets": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"drop_packets": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInterfaceStatisticsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	statistics, err := readInterfaceStatistics(d.Get("name").(string), m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("interface_statistics" + idSeparator + d.Get("name").(string))
+	for k, v := range statistics {
+		if tfErr := d.Set(k, v); tfErr != nil {
+			panic(tfErr)
+		}
+	}
+
+	return nil
+}
+
+func readInterfaceStatistics(interFace string, m interface{}, jnprSess *NetconfObject) (
+	map[string]interface{}, error) {
+	sess := m.(*Session)
+	reply, err := sess.commandXML("<get-interface-information><interface-name>"+html.EscapeString(interFace)+
+		"</interface-name><extensive/></get-interface-information>", jnprSess)
+	if err != nil {
+		if strings.Contains(err.Error(), " not found") {
+			return nil, fmt.Errorf("interface %s not found", interFace)
+		}
+
+		return nil, err
+	}
+	var interfaceInfo interfaceStatisticsInformation
+	if err := xml.Unmarshal([]byte(reply), &interfaceInfo); err != nil {
+		return nil, fmt.Errorf("failed to xml unmarshal reply : %w", err)
+	}
+	var detail interfaceStatisticsDetail
+	switch {
+	case len(interfaceInfo.PhysicalInterface) > 0:
+		detail = interfaceInfo.PhysicalInterface[0]
+	case len(interfaceInfo.LogicalInterface) > 0:
+		detail = interfaceInfo.LogicalInterface[0]
+	default:
+		return nil, fmt.Errorf("interface %s not found", interFace)
+	}
+	statistics := map[string]interface{}{
+		"admin_status": strings.TrimSpace(detail.AdminStatus),
+		"oper_status":  strings.TrimSpace(detail.OperStatus),
+		"speed":        strings.TrimSpace(detail.Speed),
+		"queue":        make([]map[string]interface{}, 0, len(detail.Queue)),
+	}
+	for k, v := range map[string]string{
+		"input_bytes":         detail.TrafficStatistics.InputBytes,
+		"input_bps":           detail.TrafficStatistics.InputBps,
+		"input_packets":       detail.TrafficStatistics.InputPackets,
+		"input_pps":           detail.TrafficStatistics.InputPps,
+		"output_bytes":        detail.TrafficStatistics.OutputBytes,
+		"output_bps":          detail.TrafficStatistics.OutputBps,
+		"output_packets":      detail.TrafficStatistics.OutputPackets,
+		"output_pps":          detail.TrafficStatistics.OutputPps,
+		"input_errors":        detail.InputErrorList.InputErrors,
+		"input_drops":         detail.InputErrorList.InputDrops,
+		"input_discards":      detail.InputErrorList.InputDiscards,
+		"input_fifo_errors":   detail.InputErrorList.InputFifoErrors,
+		"carrier_transitions": detail.OutputErrorList.CarrierTransitions,
+		"output_errors":       detail.OutputErrorList.OutputErrors,
+		"output_collisions":   detail.OutputErrorList.OutputCollisions,
+		"output_drops":        detail.OutputErrorList.OutputDrops,
+		"output_fifo_errors":  detail.OutputErrorList.OutputFifoErrors,
+	} {
+		statistics[k] = 0
+		if strings.TrimSpace(v) == "" {
+			continue
+		}
+		statistics[k], err = strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert value from '%s' to integer : %w", v, err)
+		}
+	}
+	for _, queue := range detail.Queue {
+		queueCounters := map[string]interface{}{
+			"number":              0,
+			"forwarding_class":    strings.TrimSpace(queue.ForwardingClassName),
+			"queued_packets":      0,
+			"transmitted_packets": 0,
+			"drop_packets":        0,
+		}
+		for k, v := range map[string]string{
+			"number":              queue.Number,
+			"queued_packets":      queue.QueuedPackets,
+			"transmitted_packets": queue.TransmittedPackets,
+			"drop_packets":        queue.DropPackets,
+		} {
+			if strings.TrimSpace(v) == "" {
+				continue
+			}
+			queueCounters[k], err = strconv.Atoi(strings.TrimSpace(v))
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert value from '%s' to integer : %w", v, err)
+			}
+		}
+		statistics["queue"] = append(statistics["queue"].([]map[string]interface{}), queueCounters)
+	}
+
+	return statistics, nil
+}
@@ -0,0 +1,47 @@
+package junos_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// export TESTACC_INTERFACE=<inteface> for choose interface available else it's ge-0/0/3.
+func TestAccDataSourceInterfaceStatistics_basic(t *testing.T) {
+	var testaccInterface string
+	if os.Getenv("TESTACC_INTERFACE") != "" {
+		testaccInterface = os.Getenv("TESTACC_INTERFACE")
+	} else {
+		testaccInterface = defaultInterfaceTestAcc
+	}
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceInterfaceStatisticsConfig(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.junos_interface_statistics.testacc_dataintstats",
+							"admin_status", "up"),
+						resource.TestCheckResourceAttrSet("data.junos_interface_statistics.testacc_dataintstats",
+							"input_bps"),
+						resource.TestCheckResourceAttrSet("data.junos_interface_statistics.testacc_dataintstats",
+							"output_bps"),
+						resource.TestCheckResourceAttrSet("data.junos_interface_statistics.testacc_dataintstats",
+							"queue.#"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func testAccDataSourceInterfaceStatisticsConfig(interFace string) string {
+	return `
+data junos_interface_statistics testacc_dataintstats {
+  name = "` + interFace + `"
+}
+`
+}
@@ -36,6 +36,10 @@ func TestAccDataSourceInterface_basic(t *testing.T) {
 							"inet_address.0.address", "192.0.2.1/25"),
 						resource.TestCheckResourceAttr("data.junos_interface.testacc_datainterface2",
 							"id", testaccInterface+".100"),
+						resource.TestCheckResourceAttr("data.junos_interface.testacc_datainterface3",
+							"id", testaccInterface),
+						resource.TestCheckResourceAttr("data.junos_interface.testacc_datainterface3",
+							"admin_status", "up"),
 					),
 				},
 			},
@@ -84,5 +88,9 @@ data junos_interface testacc_datainterface {
 data junos_interface testacc_datainterface2 {
   match      = "192.0.2.(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)"
 }
+
+data junos_interface testacc_datainterface3 {
+  match_description = "^testacc_datainterfaceP$"
+}
 `
 }
@@ -0,0 +1,203 @@
+package junos
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceInterfaces() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceInterfacesRead,
+		Schema: map[string]*schema.Schema{
+			"admin_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"up", "down"}, false),
+			},
+			"oper_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"up", "down"}, false),
+			},
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"has_family_inet": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"member_of_ae": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"interfaces": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"admin_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"oper_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"logical": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"inet": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"inet_address": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ether802_3ad": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInterfacesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	terse, err := readInterfaceTerse("", m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	descriptions, err := readInterfaceDescriptions(m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	names := make([]string, 0)
+	interfaces := make([]map[string]interface{}, 0)
+	for _, physical := range terse.PhysicalInterface {
+		physicalInterface := map[string]interface{}{
+			"name":         strings.TrimSpace(physical.Name),
+			"description":  descriptions[strings.TrimSpace(physical.Name)],
+			"admin_status": strings.TrimSpace(physical.AdminStatus),
+			"oper_status":  strings.TrimSpace(physical.OperStatus),
+			"logical":      false,
+			"inet":         false,
+			"inet_address": make([]string, 0),
+			"ether802_3ad": "",
+		}
+		logicalInterfaces := make([]map[string]interface{}, 0, len(physical.LogicalInterface))
+		for _, logical := range physical.LogicalInterface {
+			// 802.3ad is configured on physical interface but terse show it as family aenet of unit
+			for _, family := range logical.AddressFamily {
+				if strings.TrimSpace(family.Name) == "aenet" {
+					physicalInterface["ether802_3ad"] = strings.Split(strings.TrimSpace(family.AeBundleName), ".")[0]
+				}
+			}
+			logicalInterfaces = append(logicalInterfaces, genDataSourceInterfacesLogical(logical, descriptions))
+		}
+		for _, v := range append([]map[string]interface{}{physicalInterface}, logicalInterfaces...) {
+			if !dataSourceInterfacesFilter(d, v) {
+				continue
+			}
+			names = append(names, v["name"].(string))
+			interfaces = append(interfaces, v)
+		}
+	}
+	for _, logical := range terse.LogicalInterface {
+		logicalInterface := genDataSourceInterfacesLogical(logical, descriptions)
+		if !dataSourceInterfacesFilter(d, logicalInterface) {
+			continue
+		}
+		names = append(names, logicalInterface["name"].(string))
+		interfaces = append(interfaces, logicalInterface)
+	}
+	d.SetId("interfaces" + idSeparator + d.Get("name_prefix").(string) +
+		idSeparator + d.Get("admin_status").(string) + idSeparator + d.Get("oper_status").(string) +
+		idSeparator + strconv.FormatBool(d.Get("has_family_inet").(bool)) +
+		idSeparator + d.Get("member_of_ae").(string))
+	if tfErr := d.Set("names", names); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("interfaces", interfaces); tfErr != nil {
+		panic(tfErr)
+	}
+
+	return nil
+}
+
+func genDataSourceInterfacesLogical(logical interfaceTerseLogical,
+	descriptions map[string]string) map[string]interface{} {
+	logicalInterface := map[string]interface{}{
+		"name":         strings.TrimSpace(logical.Name),
+		"description":  descriptions[strings.TrimSpace(logical.Name)],
+		"admin_status": strings.TrimSpace(logical.AdminStatus),
+		"oper_status":  strings.TrimSpace(logical.OperStatus),
+		"logical":      true,
+		"inet":         false,
+		"inet_address": make([]string, 0),
+		"ether802_3ad": "",
+	}
+	for _, family := range logical.AddressFamily {
+		if strings.TrimSpace(family.Name) == "inet" {
+			logicalInterface["inet"] = true
+			for _, address := range family.InterfaceAddress {
+				logicalInterface["inet_address"] = append(logicalInterface["inet_address"].([]string),
+					strings.TrimSpace(address))
+			}
+		}
+	}
+
+	return logicalInterface
+}
+
+func dataSourceInterfacesFilter(d *schema.ResourceData, intFace map[string]interface{}) bool {
+	if d.Get("name_prefix").(string) != "" &&
+		!strings.HasPrefix(intFace["name"].(string), d.Get("name_prefix").(string)) {
+		return false
+	}
+	if d.Get("admin_status").(string) != "" && intFace["admin_status"].(string) != d.Get("admin_status").(string) {
+		return false
+	}
+	if d.Get("oper_status").(string) != "" && intFace["oper_status"].(string) != d.Get("oper_status").(string) {
+		return false
+	}
+	// family filter only match logical interfaces (inet is not set on physical)
+	if d.Get("has_family_inet").(bool) && !intFace["inet"].(bool) {
+		return false
+	}
+	// member_of_ae filter only match physical interfaces (ether802_3ad is not set on logical)
+	if d.Get("member_of_ae").(string) != "" && intFace["ether802_3ad"].(string) != d.Get("member_of_ae").(string) {
+		return false
+	}
+
+	return true
+}
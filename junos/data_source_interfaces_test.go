@@ -0,0 +1,101 @@
+package junos_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// export TESTACC_INTERFACE=<inteface> for choose interface available else it's ge-0/0/3.
+func TestAccDataSourceInterfaces_basic(t *testing.T) {
+	var testaccInterface string
+	if os.Getenv("TESTACC_INTERFACE") != "" {
+		testaccInterface = os.Getenv("TESTACC_INTERFACE")
+	} else {
+		testaccInterface = defaultInterfaceTestAcc
+	}
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceInterfacesConfigCreate(testaccInterface),
+				},
+				{
+					Config: testAccDataSourceInterfacesConfigData(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.junos_interfaces.testacc_datainterfaces",
+							"names.#", "1"),
+						resource.TestCheckResourceAttr("data.junos_interfaces.testacc_datainterfaces",
+							"names.0", testaccInterface+".100"),
+						resource.TestCheckResourceAttr("data.junos_interfaces.testacc_datainterfaces",
+							"interfaces.0.logical", "true"),
+						resource.TestCheckResourceAttr("data.junos_interfaces.testacc_datainterfaces",
+							"interfaces.0.inet", "true"),
+						resource.TestCheckResourceAttr("data.junos_interfaces.testacc_datainterfaces",
+							"interfaces.0.inet_address.#", "1"),
+						resource.TestCheckResourceAttr("data.junos_interfaces.testacc_datainterfaces",
+							"interfaces.0.inet_address.0", "192.0.2.1/25"),
+						resource.TestCheckResourceAttr("data.junos_interfaces.testacc_datainterfaces",
+							"interfaces.0.description", "testacc_datainterfaces"),
+						resource.TestCheckResourceAttr("data.junos_interfaces.testacc_datainterfaces2",
+							"names.#", "1"),
+						resource.TestCheckResourceAttr("data.junos_interfaces.testacc_datainterfaces2",
+							"names.0", testaccInterface+".100"),
+						resource.TestCheckResourceAttr("data.junos_interfaces.testacc_datainterfacesP",
+							"interfaces.0.description", "testacc_datainterfacesP"),
+					),
+				},
+			},
+			PreventPostDestroyRefresh: true,
+		})
+	}
+}
+
+func testAccDataSourceInterfacesConfigCreate(interFace string) string {
+	return `
+resource junos_interface testacc_datainterfacesP {
+  name         = "` + interFace + `"
+  description  = "testacc_datainterfacesP"
+  vlan_tagging = true
+}
+resource junos_interface testacc_datainterfaces {
+  name        = "${junos_interface.testacc_datainterfacesP.name}.100"
+  description = "testacc_datainterfaces"
+  inet_address {
+    address = "192.0.2.1/25"
+  }
+}
+`
+}
+
+func testAccDataSourceInterfacesConfigData(interFace string) string {
+	return `
+resource junos_interface testacc_datainterfacesP {
+  name         = "` + interFace + `"
+  description  = "testacc_datainterfacesP"
+  vlan_tagging = true
+}
+resource junos_interface testacc_datainterfaces {
+  name        = "${junos_interface.testacc_datainterfacesP.name}.100"
+  description = "testacc_datainterfaces"
+  inet_address {
+    address = "192.0.2.1/25"
+  }
+}
+
+data junos_interfaces testacc_datainterfaces {
+  name_prefix     = "` + interFace + `."
+  has_family_inet = true
+}
+data junos_interfaces testacc_datainterfaces2 {
+  name_prefix     = "` + interFace + `"
+  has_family_inet = true
+}
+data junos_interfaces testacc_datainterfacesP {
+  name_prefix = "` + interFace + `"
+}
+`
+}
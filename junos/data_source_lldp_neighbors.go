@@ -0,0 +1,137 @@
+package junos
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type lldpNeighborsInformation struct {
+	XMLName  xml.Name              `xml:"lldp-neighbors-information"`
+	Neighbor []lldpNeighborDetails `xml:"lldp-neighbor-information"`
+}
+type lldpNeighborDetails struct {
+	LocalPortID            string `xml:"lldp-local-port-id"`
+	LocalInterface         string `xml:"lldp-local-interface"`
+	LocalParentInterface   string `xml:"lldp-local-parent-interface-name"`
+	RemoteChassisIDSubtype string `xml:"lldp-remote-chassis-id-subtype"`
+	RemoteChassisID        string `xml:"lldp-remote-chassis-id"`
+	RemotePortIDSubtype    string `xml:"lldp-remote-port-id-subtype"`
+	RemotePortID           string `xml:"lldp-remote-port-id"`
+	RemotePortDescription  string `xml:"lldp-remote-port-description"`
+	RemoteSystemName       string `xml:"lldp-remote-system-name"`
+}
+
+func dataSourceLldpNeighbors() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLldpNeighborsRead,
+		Schema: map[string]*schema.Schema{
+			"local_interface": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"neighbor": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"local_interface": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"local_parent_interface": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remote_chassis_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remote_chassis_id_subtype": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remote_port_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remote_port_id_subtype": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remote_port_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remote_system_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLldpNeighborsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	neighbors, err := readLldpNeighbors(d.Get("local_interface").(string), m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("lldp_neighbors" + idSeparator + d.Get("local_interface").(string))
+	if tfErr := d.Set("neighbor", neighbors); tfErr != nil {
+		panic(tfErr)
+	}
+
+	return nil
+}
+
+func readLldpNeighbors(localInterface string,
+	m interface{}, jnprSess *NetconfObject) ([]map[string]interface{}, error) {
+	sess := m.(*Session)
+	neighbors := make([]map[string]interface{}, 0)
+	reply, err := sess.commandXML("<get-lldp-neighbors-information/>", jnprSess)
+	if err != nil {
+		return neighbors, err
+	}
+	if strings.TrimSpace(reply) == "" {
+		return neighbors, nil
+	}
+	var lldpInfo lldpNeighborsInformation
+	if err := xml.Unmarshal([]byte(reply), &lldpInfo); err != nil {
+		return neighbors, fmt.Errorf("failed to xml unmarshal reply : %w", err)
+	}
+	for _, neighbor := range lldpInfo.Neighbor {
+		// depending on platform, local interface is in lldp-local-port-id or lldp-local-interface
+		neighborLocalInterface := strings.TrimSpace(neighbor.LocalInterface)
+		if neighborLocalInterface == "" {
+			neighborLocalInterface = strings.TrimSpace(neighbor.LocalPortID)
+		}
+		if localInterface != "" && neighborLocalInterface != localInterface {
+			continue
+		}
+		neighbors = append(neighbors, map[string]interface{}{
+			"local_interface":           neighborLocalInterface,
+			"local_parent_interface":    strings.TrimSpace(neighbor.LocalParentInterface),
+			"remote_chassis_id":         strings.TrimSpace(neighbor.RemoteChassisID),
+			"remote_chassis_id_subtype": strings.TrimSpace(neighbor.RemoteChassisIDSubtype),
+			"remote_port_id":            strings.TrimSpace(neighbor.RemotePortID),
+			"remote_port_id_subtype":    strings.TrimSpace(neighbor.RemotePortIDSubtype),
+			"remote_port_description":   strings.TrimSpace(neighbor.RemotePortDescription),
+			"remote_system_name":        strings.TrimSpace(neighbor.RemoteSystemName),
+		})
+	}
+
+	return neighbors, nil
+}
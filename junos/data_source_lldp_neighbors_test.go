@@ -0,0 +1,49 @@
+package junos_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// export TESTACC_INTERFACE=<inteface> for choose interface available else it's ge-0/0/3.
+func TestAccDataSourceLldpNeighbors_basic(t *testing.T) {
+	var testaccInterface string
+	if os.Getenv("TESTACC_INTERFACE") != "" {
+		testaccInterface = os.Getenv("TESTACC_INTERFACE")
+	} else {
+		testaccInterface = defaultInterfaceTestAcc
+	}
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceLldpNeighborsConfig(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.junos_lldp_neighbors.testacc_datalldpneighbors",
+							"neighbor.#", "0"),
+						resource.TestCheckResourceAttrSet("data.junos_lldp_neighbors.testacc_datalldpneighbors2",
+							"neighbor.#"),
+						resource.TestCheckResourceAttrSet("data.junos_lldp_neighbors.testacc_datalldpneighbors3",
+							"neighbor.#"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func testAccDataSourceLldpNeighborsConfig(interFace string) string {
+	return `
+data junos_lldp_neighbors testacc_datalldpneighbors {
+  local_interface = "lo0"
+}
+data junos_lldp_neighbors testacc_datalldpneighbors2 {
+  local_interface = "` + interFace + `"
+}
+data junos_lldp_neighbors testacc_datalldpneighbors3 {}
+`
+}
@@ -0,0 +1,243 @@
+package junos
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type ospfNeighborInformation struct {
+	Neighbor  []ospfNeighborDetail `xml:"ospf-neighbor"`
+	Neighbor3 []ospfNeighborDetail `xml:"ospf3-neighbor"`
+}
+type ospfNeighborDetail struct {
+	Address       string `xml:"neighbor-address"`
+	InterfaceName string `xml:"interface-name"`
+	State         string `xml:"ospf-neighbor-state"`
+	ID            string `xml:"neighbor-id"`
+	Priority      string `xml:"neighbor-priority"`
+	Area          string `xml:"ospf-area"`
+}
+type ospfInterfaceInformation struct {
+	Interface  []ospfInterfaceDetail `xml:"ospf-interface"`
+	Interface3 []ospfInterfaceDetail `xml:"ospf3-interface"`
+}
+type ospfInterfaceDetail struct {
+	Name          string `xml:"interface-name"`
+	State         string `xml:"ospf-interface-state"`
+	Area          string `xml:"ospf-area"`
+	DrID          string `xml:"dr-id"`
+	BdrID         string `xml:"bdr-id"`
+	NeighborCount string `xml:"neighbor-count"`
+}
+
+func dataSourceOspfState() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOspfStateRead,
+		Schema: map[string]*schema.Schema{
+			"routing_instance": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          defaultWord,
+				ValidateDiagFunc: validateNameObjectJunos([]string{}),
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "v2",
+				ValidateFunc: validation.StringInSlice([]string{"v2", "v3"}, false),
+			},
+			"neighbor": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"interface": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"area": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"interface": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"area": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dr_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bdr_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"neighbor_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOspfStateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	neighbors, err := readOspfNeighbors(d.Get("routing_instance").(string), d.Get("version").(string), m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	interfaces, err := readOspfInterfaces(d.Get("routing_instance").(string), d.Get("version").(string), m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("ospf_state" + idSeparator + d.Get("version").(string) + idSeparator + d.Get("routing_instance").(string))
+	if tfErr := d.Set("neighbor", neighbors); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("interface", interfaces); tfErr != nil {
+		panic(tfErr)
+	}
+
+	return nil
+}
+
+func rpcOspfArgs(instance string) string {
+	if instance != defaultWord {
+		return "<instance>" + instance + "</instance>"
+	}
+
+	return ""
+}
+
+func readOspfNeighbors(instance, version string,
+	m interface{}, jnprSess *NetconfObject) ([]map[string]interface{}, error) {
+	sess := m.(*Session)
+	neighbors := make([]map[string]interface{}, 0)
+	ospfWord := "ospf"
+	if version == "v3" {
+		ospfWord = "ospf3"
+	}
+	rpcNeighbor := "<get-" + ospfWord + "-neighbor-information><detail/>" + rpcOspfArgs(instance) +
+		"</get-" + ospfWord + "-neighbor-information>"
+	reply, err := sess.commandXML(rpcNeighbor, jnprSess)
+	if err != nil {
+		if strings.Contains(err.Error(), "instance is not running") {
+			return neighbors, nil
+		}
+
+		return neighbors, err
+	}
+	var neighborInfo ospfNeighborInformation
+	if err := xml.Unmarshal([]byte(reply), &neighborInfo); err != nil {
+		return neighbors, fmt.Errorf("failed to xml unmarshal reply : %w", err)
+	}
+	for _, neighbor := range append(neighborInfo.Neighbor, neighborInfo.Neighbor3...) {
+		neighborState := map[string]interface{}{
+			"id":        strings.TrimSpace(neighbor.ID),
+			"address":   strings.TrimSpace(neighbor.Address),
+			"interface": strings.TrimSpace(neighbor.InterfaceName),
+			"area":      strings.TrimSpace(neighbor.Area),
+			"state":     strings.TrimSpace(neighbor.State),
+			"priority":  0,
+		}
+		if strings.TrimSpace(neighbor.Priority) != "" {
+			neighborState["priority"], err = strconv.Atoi(strings.TrimSpace(neighbor.Priority))
+			if err != nil {
+				return neighbors, fmt.Errorf("failed to convert value from '%s' to integer : %w", neighbor.Priority, err)
+			}
+		}
+		neighbors = append(neighbors, neighborState)
+	}
+
+	return neighbors, nil
+}
+
+func readOspfInterfaces(instance, version string,
+	m interface{}, jnprSess *NetconfObject) ([]map[string]interface{}, error) {
+	sess := m.(*Session)
+	interfaces := make([]map[string]interface{}, 0)
+	ospfWord := "ospf"
+	if version == "v3" {
+		ospfWord = "ospf3"
+	}
+	rpcInterface := "<get-" + ospfWord + "-interface-information>" + rpcOspfArgs(instance) +
+		"</get-" + ospfWord + "-interface-information>"
+	reply, err := sess.commandXML(rpcInterface, jnprSess)
+	if err != nil {
+		if strings.Contains(err.Error(), "instance is not running") {
+			return interfaces, nil
+		}
+
+		return interfaces, err
+	}
+	var interfaceInfo ospfInterfaceInformation
+	if err := xml.Unmarshal([]byte(reply), &interfaceInfo); err != nil {
+		return interfaces, fmt.Errorf("failed to xml unmarshal reply : %w", err)
+	}
+	for _, intFace := range append(interfaceInfo.Interface, interfaceInfo.Interface3...) {
+		interfaceState := map[string]interface{}{
+			"name":           strings.TrimSpace(intFace.Name),
+			"area":           strings.TrimSpace(intFace.Area),
+			"state":          strings.TrimSpace(intFace.State),
+			"dr_id":          strings.TrimSpace(intFace.DrID),
+			"bdr_id":         strings.TrimSpace(intFace.BdrID),
+			"neighbor_count": 0,
+		}
+		if strings.TrimSpace(intFace.NeighborCount) != "" {
+			interfaceState["neighbor_count"], err = strconv.Atoi(strings.TrimSpace(intFace.NeighborCount))
+			if err != nil {
+				return interfaces, fmt.Errorf("failed to convert value from '%s' to integer : %w",
+					intFace.NeighborCount, err)
+			}
+		}
+		interfaces = append(interfaces, interfaceState)
+	}
+
+	return interfaces, nil
+}
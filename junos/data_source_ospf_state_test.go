@@ -0,0 +1,86 @@
+package junos_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOspfState_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceOspfStateConfigCreate(),
+				},
+				{
+					Config: testAccDataSourceOspfStateConfigData(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.junos_ospf_state.testacc_dataospfstate",
+							"neighbor.#", "0"),
+						resource.TestCheckResourceAttr("data.junos_ospf_state.testacc_dataospfstate",
+							"interface.#", "1"),
+						resource.TestCheckResourceAttr("data.junos_ospf_state.testacc_dataospfstate",
+							"interface.0.name", "lo0.1"),
+						resource.TestCheckResourceAttr("data.junos_ospf_state.testacc_dataospfstate",
+							"interface.0.area", "0.0.0.0"),
+					),
+				},
+			},
+			PreventPostDestroyRefresh: true,
+		})
+	}
+}
+
+func testAccDataSourceOspfStateConfigCreate() string {
+	return `
+resource junos_routing_instance testacc_dataospfstate {
+  name = "testacc_dataospfstate"
+}
+resource junos_interface testacc_dataospfstate {
+  name             = "lo0.1"
+  routing_instance = junos_routing_instance.testacc_dataospfstate.name
+  inet_address {
+    address = "192.0.2.1/32"
+  }
+}
+resource junos_ospf_area testacc_dataospfstate {
+  area_id          = "0.0.0.0"
+  routing_instance = junos_routing_instance.testacc_dataospfstate.name
+  interface {
+    name    = junos_interface.testacc_dataospfstate.name
+    passive = true
+  }
+}
+`
+}
+
+func testAccDataSourceOspfStateConfigData() string {
+	return `
+resource junos_routing_instance testacc_dataospfstate {
+  name = "testacc_dataospfstate"
+}
+resource junos_interface testacc_dataospfstate {
+  name             = "lo0.1"
+  routing_instance = junos_routing_instance.testacc_dataospfstate.name
+  inet_address {
+    address = "192.0.2.1/32"
+  }
+}
+resource junos_ospf_area testacc_dataospfstate {
+  area_id          = "0.0.0.0"
+  routing_instance = junos_routing_instance.testacc_dataospfstate.name
+  interface {
+    name    = junos_interface.testacc_dataospfstate.name
+    passive = true
+  }
+}
+
+data junos_ospf_state testacc_dataospfstate {
+  routing_instance = junos_ospf_area.testacc_dataospfstate.routing_instance
+}
+`
+}
@@ -0,0 +1,225 @@
+package junos
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type routeInformation struct {
+	XMLName    xml.Name                `xml:"route-information"`
+	RouteTable []routeInformationTable `xml:"route-table"`
+}
+type routeInformationTable struct {
+	TableName string                  `xml:"table-name"`
+	Route     []routeInformationRoute `xml:"rt"`
+}
+type routeInformationRoute struct {
+	Destination string                       `xml:"rt-destination"`
+	Entry       []routeInformationRouteEntry `xml:"rt-entry"`
+}
+type routeInformationRouteEntry struct {
+	ActiveTag    string `xml:"active-tag"`
+	ProtocolName string `xml:"protocol-name"`
+	Preference   string `xml:"preference"`
+	Metric       string `xml:"metric"`
+	Age          struct {
+		Seconds string `xml:"seconds,attr"`
+	} `xml:"age"`
+	NextHopType string                         `xml:"nh-type"`
+	NextHop     []routeInformationRouteNextHop `xml:"nh"`
+}
+type routeInformationRouteNextHop struct {
+	SelectedNextHop *struct{} `xml:"selected-next-hop"`
+	To              string    `xml:"to"`
+	Via             string    `xml:"via"`
+}
+
+func dataSourceRoutes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRoutesRead,
+		Schema: map[string]*schema.Schema{
+			"table": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringDoesNotContainAny("<>&"),
+			},
+			"destination": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.Any(validation.IsCIDR, validation.IsIPAddress),
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringDoesNotContainAny("<>&"),
+			},
+			"exact": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"route": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"table": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destination": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"preference": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"metric": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"age": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"next_hop_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_hop": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"selected": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"to": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"via": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRoutesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.Get("exact").(bool) && d.Get("destination").(string) == "" {
+		return diag.FromErr(fmt.Errorf("'exact' need 'destination' to be set"))
+	}
+	if d.Get("table").(string) == "" && d.Get("destination").(string) == "" && d.Get("protocol").(string) == "" {
+		return diag.FromErr(fmt.Errorf("one of 'table', 'destination' or 'protocol' need to be set"))
+	}
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	routes, err := readRoutes(d.Get("table").(string), d.Get("destination").(string),
+		d.Get("protocol").(string), d.Get("exact").(bool), m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("routes" + idSeparator + d.Get("table").(string) + idSeparator + d.Get("destination").(string) +
+		idSeparator + d.Get("protocol").(string) + idSeparator + strconv.FormatBool(d.Get("exact").(bool)))
+	if tfErr := d.Set("route", routes); tfErr != nil {
+		panic(tfErr)
+	}
+
+	return nil
+}
+
+func readRoutes(table, destination, protocol string, exact bool,
+	m interface{}, jnprSess *NetconfObject) ([]map[string]interface{}, error) {
+	sess := m.(*Session)
+	routes := make([]map[string]interface{}, 0)
+	rpcRoute := "<get-route-information>"
+	if table != "" {
+		rpcRoute += "<table>" + table + "</table>"
+	}
+	if destination != "" {
+		rpcRoute += "<destination>" + destination + "</destination>"
+	}
+	if protocol != "" {
+		rpcRoute += "<protocol>" + protocol + "</protocol>"
+	}
+	if exact {
+		rpcRoute += "<exact/>"
+	}
+	rpcRoute += "</get-route-information>"
+	reply, err := sess.commandXML(rpcRoute, jnprSess)
+	if err != nil {
+		return routes, err
+	}
+	var routeInfo routeInformation
+	if err := xml.Unmarshal([]byte(reply), &routeInfo); err != nil {
+		return routes, fmt.Errorf("failed to xml unmarshal reply : %w", err)
+	}
+	for _, routeTable := range routeInfo.RouteTable {
+		for _, route := range routeTable.Route {
+			for _, entry := range route.Entry {
+				routeEntry := map[string]interface{}{
+					"table":         strings.TrimSpace(routeTable.TableName),
+					"destination":   strings.TrimSpace(route.Destination),
+					"active":        strings.TrimSpace(entry.ActiveTag) == "*",
+					"protocol":      strings.TrimSpace(entry.ProtocolName),
+					"preference":    0,
+					"metric":        0,
+					"age":           0,
+					"next_hop_type": strings.TrimSpace(entry.NextHopType),
+					"next_hop":      make([]map[string]interface{}, 0),
+				}
+				for k, v := range map[string]string{
+					"preference": entry.Preference,
+					"metric":     entry.Metric,
+					"age":        entry.Age.Seconds,
+				} {
+					if strings.TrimSpace(v) == "" {
+						continue
+					}
+					routeEntry[k], err = strconv.Atoi(strings.TrimSpace(v))
+					if err != nil {
+						return routes, fmt.Errorf("failed to convert value from '%s' to integer : %w", v, err)
+					}
+				}
+				for _, nextHop := range entry.NextHop {
+					routeEntry["next_hop"] = append(routeEntry["next_hop"].([]map[string]interface{}),
+						map[string]interface{}{
+							"selected": nextHop.SelectedNextHop != nil,
+							"to":       strings.TrimSpace(nextHop.To),
+							"via":      strings.TrimSpace(nextHop.Via),
+						})
+				}
+				routes = append(routes, routeEntry)
+			}
+		}
+	}
+
+	return routes, nil
+}
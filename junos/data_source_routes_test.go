@@ -0,0 +1,79 @@
+package junos_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRoutes_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceRoutesConfigCreate(),
+				},
+				{
+					Config: testAccDataSourceRoutesConfigData(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.junos_routes.testacc_dataroutes",
+							"route.#", "1"),
+						resource.TestCheckResourceAttr("data.junos_routes.testacc_dataroutes",
+							"route.0.table", "inet.0"),
+						resource.TestCheckResourceAttr("data.junos_routes.testacc_dataroutes",
+							"route.0.destination", "192.0.2.128/25"),
+						resource.TestCheckResourceAttr("data.junos_routes.testacc_dataroutes",
+							"route.0.active", "true"),
+						resource.TestCheckResourceAttr("data.junos_routes.testacc_dataroutes",
+							"route.0.protocol", "Static"),
+						resource.TestCheckResourceAttr("data.junos_routes.testacc_dataroutes",
+							"route.0.preference", "100"),
+						resource.TestCheckResourceAttr("data.junos_routes.testacc_dataroutes",
+							"route.0.next_hop.#", "1"),
+						resource.TestCheckResourceAttr("data.junos_routes.testacc_dataroutes",
+							"route.0.next_hop.0.via", "st0.1"),
+					),
+				},
+			},
+			PreventPostDestroyRefresh: true,
+		})
+	}
+}
+
+func testAccDataSourceRoutesConfigCreate() string {
+	return `
+resource junos_interface testacc_dataroutes {
+  name = "st0.1"
+  inet = true
+}
+resource junos_static_route testacc_dataroutes {
+  destination = "192.0.2.128/25"
+  preference  = 100
+  next_hop    = [junos_interface.testacc_dataroutes.name]
+}
+`
+}
+
+func testAccDataSourceRoutesConfigData() string {
+	return `
+resource junos_interface testacc_dataroutes {
+  name = "st0.1"
+  inet = true
+}
+resource junos_static_route testacc_dataroutes {
+  destination = "192.0.2.128/25"
+  preference  = 100
+  next_hop    = [junos_interface.testacc_dataroutes.name]
+}
+
+data junos_routes testacc_dataroutes {
+  table       = "inet.0"
+  destination = "192.0.2.128/25"
+  protocol    = "static"
+  exact       = true
+}
+`
+}
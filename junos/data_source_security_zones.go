@@ -0,0 +1,247 @@
+package junos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSecurityZones() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSecurityZonesRead,
+		Schema: map[string]*schema.Schema{
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"zone": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"interfaces": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"screen": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"inbound_services": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"inbound_protocols": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"address_book": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"network": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"dns_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"range_address_from": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"range_address_to": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"wildcard_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"address_book_set": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"address": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSecurityZonesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	if !checkCompatibilitySecurity(jnprSess) {
+		return diag.FromErr(fmt.Errorf("security zone not compatible with Junos device %s", jnprSess.Platform[0].Model))
+	}
+	zones, err := readSecurityZones(m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	names := make([]string, 0, len(zones))
+	for _, zone := range zones {
+		names = append(names, zone["name"].(string))
+	}
+	d.SetId("security_zones" + idSeparator + jnprSess.Hostname)
+	if tfErr := d.Set("names", names); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("zone", zones); tfErr != nil {
+		panic(tfErr)
+	}
+
+	return nil
+}
+
+func readSecurityZones(m interface{}, jnprSess *NetconfObject) ([]map[string]interface{}, error) {
+	sess := m.(*Session)
+	zones := make([]map[string]interface{}, 0)
+	zonesConfig, err := sess.command("show configuration security zones | display set relative", jnprSess)
+	if err != nil {
+		return zones, err
+	}
+	if zonesConfig == emptyWord {
+		return zones, nil
+	}
+	zoneNames := make([]string, 0)
+	// address-book of zones is read here with more options than resource junos_security_zone
+	addressBooks := make(map[string][]map[string]interface{})
+	addressBookSets := make(map[string][]map[string]interface{})
+	for _, item := range strings.Split(zonesConfig, "\n") {
+		if strings.Contains(item, "<configuration-output>") {
+			continue
+		}
+		if strings.Contains(item, "</configuration-output>") {
+			break
+		}
+		itemTrim := strings.TrimPrefix(item, setLineStart)
+		if !strings.HasPrefix(itemTrim, "security-zone ") {
+			continue
+		}
+		itemTrim = strings.TrimPrefix(itemTrim, "security-zone ")
+		zoneName := strings.Split(itemTrim, " ")[0]
+		if !stringInSlice(zoneName, zoneNames) {
+			zoneNames = append(zoneNames, zoneName)
+			addressBooks[zoneName] = make([]map[string]interface{}, 0)
+			addressBookSets[zoneName] = make([]map[string]interface{}, 0)
+		}
+		itemTrim = strings.TrimPrefix(itemTrim, zoneName+" ")
+		switch {
+		case strings.HasPrefix(itemTrim, "address-book address "):
+			addressBooks[zoneName] = readSecurityZonesAddress(strings.TrimPrefix(itemTrim, "address-book address "),
+				addressBooks[zoneName])
+		case strings.HasPrefix(itemTrim, "address-book address-set "):
+			addressWords := strings.Split(strings.TrimPrefix(itemTrim, "address-book address-set "), " ")
+			// addressWords[0] = name of address-set
+			// addressWords[1] = "address"
+			// addressWords[2] = name of address
+			if len(addressWords) != 3 || addressWords[1] != "address" {
+				continue
+			}
+			addressSet := map[string]interface{}{
+				"name":    addressWords[0],
+				"address": make([]string, 0),
+			}
+			addressSet, addressBookSets[zoneName] = copyAndRemoveItemMapList("name", false, addressSet,
+				addressBookSets[zoneName])
+			addressSet["address"] = append(addressSet["address"].([]string), addressWords[2])
+			addressBookSets[zoneName] = append(addressBookSets[zoneName], addressSet)
+		}
+	}
+	for _, zoneName := range zoneNames {
+		zoneOptions, err := readSecurityZone(zoneName, m, jnprSess)
+		if err != nil {
+			return zones, err
+		}
+		zones = append(zones, map[string]interface{}{
+			"name":              zoneOptions.name,
+			"interfaces":        zoneOptions.interfaces,
+			"screen":            zoneOptions.screen,
+			"inbound_services":  zoneOptions.inboundServices,
+			"inbound_protocols": zoneOptions.inboundProtocols,
+			"address_book":      addressBooks[zoneName],
+			"address_book_set":  addressBookSets[zoneName],
+		})
+	}
+
+	return zones, nil
+}
+
+func readSecurityZonesAddress(item string,
+	addressBook []map[string]interface{}) []map[string]interface{} {
+	addressName := strings.Split(item, " ")[0]
+	address := map[string]interface{}{
+		"name":               addressName,
+		"network":            "",
+		"description":        "",
+		"dns_name":           "",
+		"range_address_from": "",
+		"range_address_to":   "",
+		"wildcard_address":   "",
+	}
+	address, addressBook = copyAndRemoveItemMapList("name", false, address, addressBook)
+	item = strings.TrimPrefix(item, addressName+" ")
+	switch {
+	case strings.HasPrefix(item, "description "):
+		address["description"] = strings.Trim(strings.TrimPrefix(item, "description "), "\"")
+	case strings.HasPrefix(item, "dns-name "):
+		address["dns_name"] = strings.Split(strings.TrimPrefix(item, "dns-name "), " ")[0]
+	case strings.HasPrefix(item, "range-address "):
+		rangeWords := strings.Split(strings.TrimPrefix(item, "range-address "), " ")
+		// rangeWords[0] = start of range
+		// rangeWords[1] = "to"
+		// rangeWords[2] = end of range
+		address["range_address_from"] = rangeWords[0]
+		if len(rangeWords) == 3 && rangeWords[1] == "to" {
+			address["range_address_to"] = rangeWords[2]
+		}
+	case strings.HasPrefix(item, "wildcard-address "):
+		address["wildcard_address"] = strings.TrimPrefix(item, "wildcard-address ")
+	case !strings.Contains(item, " "):
+		address["network"] = item
+	}
+
+	return append(addressBook, address)
+}
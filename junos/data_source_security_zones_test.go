@@ -0,0 +1,89 @@
+package junos_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecurityZones_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceSecurityZonesConfigCreate(),
+				},
+				{
+					Config: testAccDataSourceSecurityZonesConfigData(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckTypeSetElemAttr("data.junos_security_zones.testacc_datazones",
+							"names.*", "testacc_datazones"),
+						resource.TestCheckTypeSetElemNestedAttrs("data.junos_security_zones.testacc_datazones",
+							"zone.*", map[string]string{
+								"name":                         "testacc_datazones",
+								"interfaces.#":                 "1",
+								"interfaces.0":                 "st0.1",
+								"inbound_services.#":           "1",
+								"inbound_services.0":           "ssh",
+								"address_book.#":               "1",
+								"address_book.0.name":          "testacc_datazones",
+								"address_book.0.network":       "192.0.2.1/32",
+								"address_book_set.#":           "1",
+								"address_book_set.0.address.#": "1",
+							}),
+					),
+				},
+			},
+			PreventPostDestroyRefresh: true,
+		})
+	}
+}
+
+func testAccDataSourceSecurityZonesConfigCreate() string {
+	return `
+resource junos_security_zone testacc_datazones {
+  name             = "testacc_datazones"
+  inbound_services = ["ssh"]
+  address_book {
+    name    = "testacc_datazones"
+    network = "192.0.2.1/32"
+  }
+  address_book_set {
+    name    = "testacc_datazones_set"
+    address = ["testacc_datazones"]
+  }
+}
+resource junos_interface testacc_datazones {
+  name          = "st0.1"
+  security_zone = junos_security_zone.testacc_datazones.name
+}
+`
+}
+
+func testAccDataSourceSecurityZonesConfigData() string {
+	return `
+resource junos_security_zone testacc_datazones {
+  name             = "testacc_datazones"
+  inbound_services = ["ssh"]
+  address_book {
+    name    = "testacc_datazones"
+    network = "192.0.2.1/32"
+  }
+  address_book_set {
+    name    = "testacc_datazones_set"
+    address = ["testacc_datazones"]
+  }
+}
+resource junos_interface testacc_datazones {
+  name          = "st0.1"
+  security_zone = junos_security_zone.testacc_datazones.name
+}
+
+data junos_security_zones testacc_datazones {
+  depends_on = [junos_interface.testacc_datazones]
+}
+`
+}
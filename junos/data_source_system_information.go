@@ -0,0 +1,306 @@
+package junos
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// reply of rpc without XMLName to accept single routing-engine result
+// and multi-routing-engine-results (chassis cluster) result.
+type chassisInventoryReply struct {
+	Chassis []chassisInventoryChassis `xml:"chassis"`
+	Item    []struct {
+		ReName  string                    `xml:"re-name"`
+		Chassis []chassisInventoryChassis `xml:"chassis-inventory>chassis"`
+	} `xml:"multi-routing-engine-item"`
+}
+type chassisInventoryChassis struct {
+	Name         string                   `xml:"name"`
+	SerialNumber string                   `xml:"serial-number"`
+	Description  string                   `xml:"description"`
+	Module       []chassisInventoryModule `xml:"chassis-module"`
+}
+type routeEngineInformationReply struct {
+	RouteEngine []routeEngineDetails `xml:"route-engine"`
+	Item        []struct {
+		ReName      string               `xml:"re-name"`
+		RouteEngine []routeEngineDetails `xml:"route-engine-information>route-engine"`
+	} `xml:"multi-routing-engine-item"`
+}
+type routeEngineDetails struct {
+	Slot            string `xml:"slot"`
+	MastershipState string `xml:"mastership-state"`
+	Status          string `xml:"status"`
+	Model           string `xml:"model"`
+}
+type chassisClusterStatus struct {
+	XMLName         xml.Name `xml:"chassis-cluster-status"`
+	ClusterID       string   `xml:"cluster-id"`
+	RedundancyGroup []struct {
+		ID         string   `xml:"redundancy-group-id"`
+		DeviceName []string `xml:"device-stats>device-name"`
+		Priority   []string `xml:"device-stats>device-priority"`
+		Status     []string `xml:"device-stats>redundancy-group-status"`
+	} `xml:"redundancy-group"`
+}
+
+func dataSourceSystemInformation() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSystemInformationRead,
+		Schema: map[string]*schema.Schema{
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"serial_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"routing_engine": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slot": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mastership_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"model": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"chassis_cluster": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"chassis_cluster_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"chassis_cluster_redundancy_group": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"node": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"priority": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSystemInformationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	serialNumber, err := readSystemInformationSerialNumber(m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	routingEngines, err := readSystemInformationRoutingEngines(m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	clusterID := 0
+	clusterRedundancyGroups := make([]map[string]interface{}, 0)
+	if checkCompatibilitySecurity(jnprSess) {
+		clusterID, clusterRedundancyGroups, err = readSystemInformationChassisCluster(m, jnprSess)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(jnprSess.Hostname)
+	if tfErr := d.Set("hostname", jnprSess.Hostname); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("model", jnprSess.Platform[0].Model); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("version", jnprSess.Platform[0].Version); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("serial_number", serialNumber); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("routing_engine", routingEngines); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("chassis_cluster", clusterID != 0); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("chassis_cluster_id", clusterID); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("chassis_cluster_redundancy_group", clusterRedundancyGroups); tfErr != nil {
+		panic(tfErr)
+	}
+
+	return nil
+}
+
+func readSystemInformationSerialNumber(m interface{}, jnprSess *NetconfObject) (string, error) {
+	sess := m.(*Session)
+	reply, err := sess.commandXML("<get-chassis-inventory/>", jnprSess)
+	if err != nil {
+		return "", err
+	}
+	var inventory chassisInventoryReply
+	if err := xml.Unmarshal([]byte(reply), &inventory); err != nil {
+		return "", fmt.Errorf("failed to xml unmarshal reply : %w", err)
+	}
+	if len(inventory.Chassis) > 0 {
+		return strings.TrimSpace(inventory.Chassis[0].SerialNumber), nil
+	}
+	// chassis cluster: serial number of first node
+	for _, item := range inventory.Item {
+		if len(item.Chassis) > 0 {
+			return strings.TrimSpace(item.Chassis[0].SerialNumber), nil
+		}
+	}
+
+	return "", nil
+}
+
+func readSystemInformationRoutingEngines(m interface{}, jnprSess *NetconfObject) ([]map[string]interface{}, error) {
+	sess := m.(*Session)
+	routingEngines := make([]map[string]interface{}, 0)
+	reply, err := sess.commandXML("<get-route-engine-information/>", jnprSess)
+	if err != nil {
+		return routingEngines, err
+	}
+	var routeEngineInfo routeEngineInformationReply
+	if err := xml.Unmarshal([]byte(reply), &routeEngineInfo); err != nil {
+		return routingEngines, fmt.Errorf("failed to xml unmarshal reply : %w", err)
+	}
+	for _, routeEngine := range routeEngineInfo.RouteEngine {
+		routingEngines = append(routingEngines, genSystemInformationRoutingEngine("", routeEngine))
+	}
+	for _, item := range routeEngineInfo.Item {
+		for _, routeEngine := range item.RouteEngine {
+			routingEngines = append(routingEngines,
+				genSystemInformationRoutingEngine(strings.TrimSpace(item.ReName), routeEngine))
+		}
+	}
+
+	return routingEngines, nil
+}
+
+func genSystemInformationRoutingEngine(node string, routeEngine routeEngineDetails) map[string]interface{} {
+	return map[string]interface{}{
+		"node":             node,
+		"slot":             strings.TrimSpace(routeEngine.Slot),
+		"mastership_state": strings.TrimSpace(routeEngine.MastershipState),
+		"status":           strings.TrimSpace(routeEngine.Status),
+		"model":            strings.TrimSpace(routeEngine.Model),
+	}
+}
+
+func readSystemInformationChassisCluster(m interface{}, jnprSess *NetconfObject) (
+	int, []map[string]interface{}, error) {
+	sess := m.(*Session)
+	redundancyGroups := make([]map[string]interface{}, 0)
+	reply, err := sess.commandXML("<get-chassis-cluster-status/>", jnprSess)
+	if err != nil {
+		if strings.Contains(err.Error(), "Chassis cluster is not enabled") {
+			return 0, redundancyGroups, nil
+		}
+
+		return 0, redundancyGroups, err
+	}
+	var clusterStatus chassisClusterStatus
+	if err := xml.Unmarshal([]byte(reply), &clusterStatus); err != nil {
+		return 0, redundancyGroups, fmt.Errorf("failed to xml unmarshal reply : %w", err)
+	}
+	clusterID, err := strconv.Atoi(strings.TrimSpace(clusterStatus.ClusterID))
+	if err != nil {
+		return 0, redundancyGroups, fmt.Errorf("failed to convert value from '%s' to integer : %w",
+			clusterStatus.ClusterID, err)
+	}
+	for _, group := range clusterStatus.RedundancyGroup {
+		redundancyGroup := map[string]interface{}{
+			"id":   0,
+			"node": make([]map[string]interface{}, 0),
+		}
+		redundancyGroup["id"], err = strconv.Atoi(strings.TrimSpace(group.ID))
+		if err != nil {
+			return 0, redundancyGroups, fmt.Errorf("failed to convert value from '%s' to integer : %w", group.ID, err)
+		}
+		for i, deviceName := range group.DeviceName {
+			node := map[string]interface{}{
+				"name":     strings.TrimSpace(deviceName),
+				"priority": 0,
+				"status":   "",
+			}
+			if i < len(group.Priority) {
+				node["priority"], err = strconv.Atoi(strings.TrimSpace(group.Priority[i]))
+				if err != nil {
+					return 0, redundancyGroups, fmt.Errorf("failed to convert value from '%s' to integer : %w",
+						group.Priority[i], err)
+				}
+			}
+			if i < len(group.Status) {
+				node["status"] = strings.TrimSpace(group.Status[i])
+			}
+			redundancyGroup["node"] = append(redundancyGroup["node"].([]map[string]interface{}), node)
+		}
+		redundancyGroups = append(redundancyGroups, redundancyGroup)
+	}
+
+	return clusterID, redundancyGroups, nil
+}
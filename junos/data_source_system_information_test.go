@@ -0,0 +1,38 @@
+package junos_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSystemInformation_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceSystemInformationConfig(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet("data.junos_system_information.testacc_datasysteminfo",
+							"hostname"),
+						resource.TestCheckResourceAttrSet("data.junos_system_information.testacc_datasysteminfo",
+							"model"),
+						resource.TestCheckResourceAttrSet("data.junos_system_information.testacc_datasysteminfo",
+							"version"),
+						resource.TestCheckResourceAttr("data.junos_system_information.testacc_datasysteminfo",
+							"chassis_cluster", "false"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func testAccDataSourceSystemInformationConfig() string {
+	return `
+data junos_system_information testacc_datasysteminfo {}
+`
+}
@@ -0,0 +1,241 @@
+package junos
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type vlansInterfaceSwitching struct {
+	name    string
+	trunk   bool
+	members []string
+}
+
+func dataSourceVlans() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVlansRead,
+		Schema: map[string]*schema.Schema{
+			"vlan": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vlan_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"l3_interface": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tagged_interfaces": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"untagged_interfaces": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVlansRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	vlans, err := readVlans(m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("vlans" + idSeparator + jnprSess.Hostname)
+	if tfErr := d.Set("vlan", vlans); tfErr != nil {
+		panic(tfErr)
+	}
+
+	return nil
+}
+
+func readVlans(m interface{}, jnprSess *NetconfObject) ([]map[string]interface{}, error) {
+	sess := m.(*Session)
+	vlans := make([]map[string]interface{}, 0)
+	vlansConfig, err := sess.command("show configuration vlans | display set relative", jnprSess)
+	if err != nil {
+		return vlans, err
+	}
+	if vlansConfig == emptyWord {
+		return vlans, nil
+	}
+	vlansIndex := make(map[string]int)
+	for _, item := range strings.Split(vlansConfig, "\n") {
+		if strings.Contains(item, "<configuration-output>") {
+			continue
+		}
+		if strings.Contains(item, "</configuration-output>") {
+			break
+		}
+		itemTrim := strings.TrimPrefix(item, setLineStart)
+		if itemTrim == "" {
+			continue
+		}
+		vlanName := strings.Split(itemTrim, " ")[0]
+		if _, ok := vlansIndex[vlanName]; !ok {
+			vlansIndex[vlanName] = len(vlans)
+			vlans = append(vlans, map[string]interface{}{
+				"name":                vlanName,
+				"vlan_id":             0,
+				"description":         "",
+				"l3_interface":        "",
+				"tagged_interfaces":   make([]string, 0),
+				"untagged_interfaces": make([]string, 0),
+			})
+		}
+		vlan := vlans[vlansIndex[vlanName]]
+		itemTrim = strings.TrimPrefix(itemTrim, vlanName+" ")
+		switch {
+		case strings.HasPrefix(itemTrim, "description "):
+			vlan["description"] = strings.Trim(strings.TrimPrefix(itemTrim, "description "), "\"")
+		case strings.HasPrefix(itemTrim, "vlan-id "):
+			// vlan-id can also be 'none' or 'all'
+			if vlanID, err := strconv.Atoi(strings.TrimPrefix(itemTrim, "vlan-id ")); err == nil {
+				vlan["vlan_id"] = vlanID
+			}
+		case strings.HasPrefix(itemTrim, "l3-interface "):
+			vlan["l3_interface"] = strings.TrimPrefix(itemTrim, "l3-interface ")
+		case strings.HasPrefix(itemTrim, "interface "):
+			// members directly in vlan are access interfaces
+			interFace := strings.Split(strings.TrimPrefix(itemTrim, "interface "), " ")[0]
+			if !stringInSlice(interFace, vlan["untagged_interfaces"].([]string)) {
+				vlan["untagged_interfaces"] = append(vlan["untagged_interfaces"].([]string), interFace)
+			}
+		}
+	}
+	interfacesSwitching, nativeVlans, err := readVlansInterfaces(m, jnprSess)
+	if err != nil {
+		return vlans, err
+	}
+	for _, interfaceSwitching := range interfacesSwitching {
+		nativeVlan := nativeVlans[strings.Split(interfaceSwitching.name, ".")[0]]
+		for _, vlan := range vlans {
+			if !vlansMembersMatch(interfaceSwitching.members, vlan, interfaceSwitching.trunk) {
+				continue
+			}
+			if interfaceSwitching.trunk && (nativeVlan == 0 || nativeVlan != vlan["vlan_id"].(int)) {
+				if !stringInSlice(interfaceSwitching.name, vlan["tagged_interfaces"].([]string)) {
+					vlan["tagged_interfaces"] = append(vlan["tagged_interfaces"].([]string), interfaceSwitching.name)
+				}
+			} else if !stringInSlice(interfaceSwitching.name, vlan["untagged_interfaces"].([]string)) {
+				vlan["untagged_interfaces"] = append(vlan["untagged_interfaces"].([]string), interfaceSwitching.name)
+			}
+		}
+	}
+
+	return vlans, nil
+}
+
+func readVlansInterfaces(m interface{}, jnprSess *NetconfObject) (
+	[]*vlansInterfaceSwitching, map[string]int, error) {
+	sess := m.(*Session)
+	interfacesSwitching := make([]*vlansInterfaceSwitching, 0)
+	nativeVlans := make(map[string]int)
+	interfacesConfig, err := sess.command("show configuration interfaces | display set relative", jnprSess)
+	if err != nil {
+		return interfacesSwitching, nativeVlans, err
+	}
+	if interfacesConfig == emptyWord {
+		return interfacesSwitching, nativeVlans, nil
+	}
+	interfacesIndex := make(map[string]int)
+	for _, item := range strings.Split(interfacesConfig, "\n") {
+		if strings.Contains(item, "<configuration-output>") {
+			continue
+		}
+		if strings.Contains(item, "</configuration-output>") {
+			break
+		}
+		itemTrim := strings.TrimPrefix(item, setLineStart)
+		itemWords := strings.Split(itemTrim, " ")
+		switch {
+		case len(itemWords) == 3 && itemWords[1] == "native-vlan-id":
+			nativeVlans[itemWords[0]], err = strconv.Atoi(itemWords[2])
+			if err != nil {
+				return interfacesSwitching, nativeVlans,
+					fmt.Errorf("failed to convert value from '%s' to integer : %w", itemTrim, err)
+			}
+		case len(itemWords) > 5 && itemWords[1] == "unit" && itemWords[3] == "family" &&
+			itemWords[4] == "ethernet-switching":
+			interfaceName := itemWords[0] + "." + itemWords[2]
+			if _, ok := interfacesIndex[interfaceName]; !ok {
+				interfacesIndex[interfaceName] = len(interfacesSwitching)
+				interfacesSwitching = append(interfacesSwitching, &vlansInterfaceSwitching{
+					name:    interfaceName,
+					members: make([]string, 0),
+				})
+			}
+			interfaceSwitching := interfacesSwitching[interfacesIndex[interfaceName]]
+			switch options := strings.Join(itemWords[5:], " "); {
+			case options == "interface-mode trunk", options == "port-mode trunk":
+				interfaceSwitching.trunk = true
+			case strings.HasPrefix(options, "vlan members "):
+				interfaceSwitching.members = append(interfaceSwitching.members,
+					strings.TrimPrefix(options, "vlan members "))
+			case strings.HasPrefix(options, "native-vlan-id "):
+				// legacy form of native-vlan-id under family ethernet-switching
+				nativeVlans[itemWords[0]], err = strconv.Atoi(strings.TrimPrefix(options, "native-vlan-id "))
+				if err != nil {
+					return interfacesSwitching, nativeVlans,
+						fmt.Errorf("failed to convert value from '%s' to integer : %w", itemTrim, err)
+				}
+			}
+		}
+	}
+
+	return interfacesSwitching, nativeVlans, nil
+}
+
+// vlansMembersMatch check if vlan is in members list with name, id, range of id or 'all' (for trunk).
+func vlansMembersMatch(members []string, vlan map[string]interface{}, trunk bool) bool {
+	vlanID := vlan["vlan_id"].(int)
+	for _, member := range members {
+		switch {
+		case member == vlan["name"].(string):
+			return true
+		case member == "all" && trunk:
+			return true
+		case vlanID == 0:
+			continue
+		case member == strconv.Itoa(vlanID):
+			return true
+		case strings.Contains(member, "-"):
+			memberRange := strings.Split(member, "-")
+			rangeStart, errStart := strconv.Atoi(memberRange[0])
+			rangeEnd, errEnd := strconv.Atoi(memberRange[len(memberRange)-1])
+			if errStart == nil && errEnd == nil && vlanID >= rangeStart && vlanID <= rangeEnd {
+				return true
+			}
+		}
+	}
+
+	return false
+}
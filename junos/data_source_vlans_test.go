@@ -0,0 +1,101 @@
+package junos_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// export TESTACC_INTERFACE=<inteface> for choose interface available else it's ge-0/0/3.
+func TestAccDataSourceVlans_basic(t *testing.T) {
+	var testaccInterface string
+	if os.Getenv("TESTACC_INTERFACE") != "" {
+		testaccInterface = os.Getenv("TESTACC_INTERFACE")
+	} else {
+		testaccInterface = defaultInterfaceTestAcc
+	}
+	if os.Getenv("TESTACC_SWITCH") != "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccDataSourceVlansConfigCreate(testaccInterface),
+				},
+				{
+					Config: testAccDataSourceVlansConfigData(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckTypeSetElemNestedAttrs("data.junos_vlans.testacc_datavlans",
+							"vlan.*", map[string]string{
+								"name":                  "testacc_datavlans100",
+								"vlan_id":               "100",
+								"l3_interface":          "irb.100",
+								"tagged_interfaces.#":   "0",
+								"untagged_interfaces.#": "1",
+								"untagged_interfaces.0": testaccInterface + ".0",
+							}),
+						resource.TestCheckTypeSetElemNestedAttrs("data.junos_vlans.testacc_datavlans",
+							"vlan.*", map[string]string{
+								"name":                  "testacc_datavlans101",
+								"vlan_id":               "101",
+								"tagged_interfaces.#":   "1",
+								"tagged_interfaces.0":   testaccInterface + ".0",
+								"untagged_interfaces.#": "0",
+							}),
+					),
+				},
+			},
+			PreventPostDestroyRefresh: true,
+		})
+	}
+}
+
+func testAccDataSourceVlansConfigCreate(interFace string) string {
+	return `
+resource junos_vlan testacc_datavlans100 {
+  name         = "testacc_datavlans100"
+  vlan_id      = 100
+  l3_interface = "irb.100"
+}
+resource junos_vlan testacc_datavlans101 {
+  name    = "testacc_datavlans101"
+  vlan_id = 101
+}
+resource junos_interface testacc_datavlans {
+  name         = "` + interFace + `"
+  description  = "testacc_datavlans"
+  trunk        = true
+  vlan_native  = junos_vlan.testacc_datavlans100.vlan_id
+  vlan_members = ["100-101"]
+}
+`
+}
+
+func testAccDataSourceVlansConfigData(interFace string) string {
+	return `
+resource junos_vlan testacc_datavlans100 {
+  name         = "testacc_datavlans100"
+  vlan_id      = 100
+  l3_interface = "irb.100"
+}
+resource junos_vlan testacc_datavlans101 {
+  name    = "testacc_datavlans101"
+  vlan_id = 101
+}
+resource junos_interface testacc_datavlans {
+  name         = "` + interFace + `"
+  description  = "testacc_datavlans"
+  trunk        = true
+  vlan_native  = junos_vlan.testacc_datavlans100.vlan_id
+  vlan_members = ["100-101"]
+}
+
+data junos_vlans testacc_datavlans {
+  depends_on = [
+    junos_vlan.testacc_datavlans101,
+    junos_interface.testacc_datavlans,
+  ]
+}
+`
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 	"net"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -209,3 +210,22 @@ func checkStringHasPrefixInList(s string, list []string) bool {
 
 	return false
 }
+
+// convert file size of Junos (can be with suffix k, m or g) to integer.
+func convertSizeWithSuffix(size string) (int, error) {
+	multiplier := 1
+	switch {
+	case strings.HasSuffix(size, "k"):
+		multiplier = 1024
+	case strings.HasSuffix(size, "m"):
+		multiplier = 1024 * 1024
+	case strings.HasSuffix(size, "g"):
+		multiplier = 1024 * 1024 * 1024
+	}
+	sizeInt, err := strconv.Atoi(strings.TrimRight(size, "kmg"))
+	if err != nil {
+		return 0, err
+	}
+
+	return sizeInt * multiplier, nil
+}
@@ -0,0 +1,26 @@
+package junos
+
+import (
+	"testing"
+)
+
+func TestConvertSizeWithSuffix(t *testing.T) {
+	sizes := map[string]int{
+		"2048": 2048,
+		"10k":  10240,
+		"2m":   2097152,
+		"1g":   1073741824,
+	}
+	for size, expected := range sizes {
+		result, err := convertSizeWithSuffix(size)
+		if err != nil {
+			t.Errorf("convertSizeWithSuffix(%q) returned error: %s", size, err)
+		}
+		if result != expected {
+			t.Errorf("convertSizeWithSuffix(%q) = %d, expected %d", size, result, expected)
+		}
+	}
+	if _, err := convertSizeWithSuffix("10x"); err == nil {
+		t.Errorf("convertSizeWithSuffix(%q) expected error", "10x")
+	}
+}
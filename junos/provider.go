@@ -93,7 +93,19 @@ func Provider() *schema.Provider {
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"junos_interface": dataSourceInterface(),
+			"junos_alarms":               dataSourceAlarms(),
+			"junos_bgp_neighbors":        dataSourceBgpNeighbors(),
+			"junos_chassis_hardware":     dataSourceChassisHardware(),
+			"junos_configuration":        dataSourceConfiguration(),
+			"junos_interface":            dataSourceInterface(),
+			"junos_interface_statistics": dataSourceInterfaceStatistics(),
+			"junos_interfaces":           dataSourceInterfaces(),
+			"junos_lldp_neighbors":       dataSourceLldpNeighbors(),
+			"junos_ospf_state":           dataSourceOspfState(),
+			"junos_routes":               dataSourceRoutes(),
+			"junos_security_zones":       dataSourceSecurityZones(),
+			"junos_system_information":   dataSourceSystemInformation(),
+			"junos_vlans":                dataSourceVlans(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"junos_aggregate_route":                                      resourceAggregateRoute(),
@@ -101,8 +113,12 @@ func Provider() *schema.Provider {
 			"junos_application":                                          resourceApplication(),
 			"junos_bgp_group":                                            resourceBgpGroup(),
 			"junos_bgp_neighbor":                                         resourceBgpNeighbor(),
+			"junos_class_of_service_interface":                           resourceClassOfServiceInterface(),
 			"junos_firewall_filter":                                      resourceFirewallFilter(),
 			"junos_firewall_policer":                                     resourceFirewallPolicer(),
+			"junos_forwardingoptions_dhcp_relay":                         resourceForwardingOptionsDhcpRelay(),
+			"junos_forwardingoptions_packet_capture":                     resourceForwardingOptionsPacketCapture(),
+			"junos_forwardingoptions_sampling_instance":                  resourceForwardingOptionsSamplingInstance(),
 			"junos_interface":                                            resourceInterface(),
 			"junos_ospf_area":                                            resourceOspfArea(),
 			"junos_policyoptions_as_path_group":                          resourcePolicyoptionsAsPathGroup(),
@@ -114,6 +130,7 @@ func Provider() *schema.Provider {
 			"junos_routing_instance":                                     resourceRoutingInstance(),
 			"junos_routing_options":                                      resourceRoutingOptions(),
 			"junos_security":                                             resourceSecurity(),
+			"junos_security_datapath_debug":                              resourceSecurityDatapathDebug(),
 			"junos_security_ike_gateway":                                 resourceIkeGateway(),
 			"junos_security_ike_policy":                                  resourceIkePolicy(),
 			"junos_security_ike_proposal":                                resourceIkeProposal(),
@@ -133,6 +150,9 @@ func Provider() *schema.Provider {
 			"junos_security_utm_profile_web_filtering_juniper_local":     resourceSecurityUtmProfileWebFilteringLocal(),
 			"junos_security_utm_profile_web_filtering_websense_redirect": resourceSecurityUtmProfileWebFilteringWebsense(),
 			"junos_security_zone":                                        resourceSecurityZone(),
+			"junos_services_flowmonitoring_vipfix_template":              resourceServicesFlowMonitoringVIPFIXTemplate(),
+			"junos_services_ip_monitoring_policy":                        resourceServicesIPMonitoringPolicy(),
+			"junos_services_rpm_probe":                                   resourceServicesRpmProbe(),
 			"junos_static_route":                                         resourceStaticRoute(),
 			"junos_system":                                               resourceSystem(),
 			"junos_system_ntp_server":                                    resourceSystemNtpServer(),
@@ -0,0 +1,528 @@
+package junos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type cosInterfaceOptions struct {
+	name                        string
+	inputTrafficControlProfile  string
+	outputTrafficControlProfile string
+	schedulerMap                string
+	shapingRate                 string
+	unit                        []map[string]interface{}
+}
+
+func resourceClassOfServiceInterface() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceClassOfServiceInterfaceCreate,
+		ReadContext:   resourceClassOfServiceInterfaceRead,
+		UpdateContext: resourceClassOfServiceInterfaceUpdate,
+		DeleteContext: resourceClassOfServiceInterfaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceClassOfServiceInterfaceImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if strings.Contains(value, ".") {
+						errors = append(errors, fmt.Errorf(
+							"%q in %q cannot have a dot, use unit block for logical interface", value, k))
+					}
+
+					return
+				},
+			},
+			"input_traffic_control_profile": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateNameObjectJunos([]string{}),
+			},
+			"output_traffic_control_profile": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateNameObjectJunos([]string{}),
+			},
+			"scheduler_map": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateNameObjectJunos([]string{}),
+			},
+			"shaping_rate": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"unit": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"classifiers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: genSchemaClassOfServiceInterfaceCodePoints(),
+							},
+						},
+						"rewrite_rules": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: genSchemaClassOfServiceInterfaceCodePoints(),
+							},
+						},
+						"input_traffic_control_profile": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateNameObjectJunos([]string{}),
+						},
+						"output_traffic_control_profile": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateNameObjectJunos([]string{}),
+						},
+						"scheduler_map": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateNameObjectJunos([]string{}),
+						},
+						"shaping_rate": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func genSchemaClassOfServiceInterfaceCodePoints() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"dscp": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validateNameObjectJunos([]string{}),
+		},
+		"dscp_ipv6": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validateNameObjectJunos([]string{}),
+		},
+		"exp": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validateNameObjectJunos([]string{}),
+		},
+		"ieee_802_1": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validateNameObjectJunos([]string{}),
+		},
+		"inet_precedence": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validateNameObjectJunos([]string{}),
+		},
+	}
+}
+
+func resourceClassOfServiceInterfaceCreate(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	cosInterfaceExists, err := checkClassOfServiceInterfaceExists(d.Get("name").(string), m, jnprSess)
+	if err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if cosInterfaceExists {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(fmt.Errorf("class-of-service interface %v already exists", d.Get("name").(string)))
+	}
+
+	if err := setClassOfServiceInterface(d, m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("create resource junos_class_of_service_interface", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	cosInterfaceExists, err = checkClassOfServiceInterfaceExists(d.Get("name").(string), m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if cosInterfaceExists {
+		d.SetId(d.Get("name").(string))
+	} else {
+		return diag.FromErr(fmt.Errorf("class-of-service interface %v not exists after commit "+
+			"=> check your config", d.Get("name").(string)))
+	}
+
+	return resourceClassOfServiceInterfaceRead(ctx, d, m)
+}
+func resourceClassOfServiceInterfaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	mutex.Lock()
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		mutex.Unlock()
+
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	cosInterfaceOptions, err := readClassOfServiceInterface(d.Get("name").(string), m, jnprSess)
+	mutex.Unlock()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if cosInterfaceOptions.name == "" {
+		d.SetId("")
+	} else {
+		fillClassOfServiceInterfaceData(d, cosInterfaceOptions)
+	}
+
+	return nil
+}
+func resourceClassOfServiceInterfaceUpdate(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.Partial(true)
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	if err := delClassOfServiceInterface(d.Get("name").(string), m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := setClassOfServiceInterface(d, m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("update resource junos_class_of_service_interface", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	d.Partial(false)
+
+	return resourceClassOfServiceInterfaceRead(ctx, d, m)
+}
+func resourceClassOfServiceInterfaceDelete(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	if err := delClassOfServiceInterface(d.Get("name").(string), m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("delete resource junos_class_of_service_interface", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+func resourceClassOfServiceInterfaceImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer sess.closeSession(jnprSess)
+	result := make([]*schema.ResourceData, 1)
+
+	cosInterfaceExists, err := checkClassOfServiceInterfaceExists(d.Id(), m, jnprSess)
+	if err != nil {
+		return nil, err
+	}
+	if !cosInterfaceExists {
+		return nil, fmt.Errorf("don't find class-of-service interface with id '%v' (id must be <name>)", d.Id())
+	}
+	cosInterfaceOptions, err := readClassOfServiceInterface(d.Id(), m, jnprSess)
+	if err != nil {
+		return nil, err
+	}
+	fillClassOfServiceInterfaceData(d, cosInterfaceOptions)
+
+	result[0] = d
+
+	return result, nil
+}
+
+func checkClassOfServiceInterfaceExists(interFace string, m interface{}, jnprSess *NetconfObject) (bool, error) {
+	sess := m.(*Session)
+	cosInterfaceConfig, err := sess.command("show configuration"+
+		" class-of-service interfaces "+interFace+" | display set", jnprSess)
+	if err != nil {
+		return false, err
+	}
+	if cosInterfaceConfig == emptyWord {
+		return false, nil
+	}
+
+	return true, nil
+}
+func setClassOfServiceInterface(d *schema.ResourceData, m interface{}, jnprSess *NetconfObject) error {
+	sess := m.(*Session)
+
+	setPrefix := "set class-of-service interfaces " + d.Get("name").(string)
+	configSet := make([]string, 0)
+
+	if d.Get("input_traffic_control_profile").(string) != "" {
+		configSet = append(configSet, setPrefix+" input-traffic-control-profile "+
+			d.Get("input_traffic_control_profile").(string))
+	}
+	if d.Get("output_traffic_control_profile").(string) != "" {
+		configSet = append(configSet, setPrefix+" output-traffic-control-profile "+
+			d.Get("output_traffic_control_profile").(string))
+	}
+	if d.Get("scheduler_map").(string) != "" {
+		configSet = append(configSet, setPrefix+" scheduler-map "+d.Get("scheduler_map").(string))
+	}
+	if d.Get("shaping_rate").(string) != "" {
+		configSet = append(configSet, setPrefix+" shaping-rate "+d.Get("shaping_rate").(string))
+	}
+	unitNameList := make([]string, 0)
+	for _, v := range d.Get("unit").([]interface{}) {
+		unit := v.(map[string]interface{})
+		if stringInSlice(unit["name"].(string), unitNameList) {
+			return fmt.Errorf("multiple unit blocks with the same name %s", unit["name"].(string))
+		}
+		unitNameList = append(unitNameList, unit["name"].(string))
+		setPrefixUnit := setPrefix + " unit " + unit["name"].(string)
+		configSetLenBeforeUnit := len(configSet)
+		for _, v2 := range unit["classifiers"].([]interface{}) {
+			if v2 != nil {
+				configSet = setClassOfServiceInterfaceCodePoints(setPrefixUnit+" classifiers ",
+					configSet, v2.(map[string]interface{}))
+			}
+		}
+		for _, v2 := range unit["rewrite_rules"].([]interface{}) {
+			if v2 != nil {
+				configSet = setClassOfServiceInterfaceCodePoints(setPrefixUnit+" rewrite-rules ",
+					configSet, v2.(map[string]interface{}))
+			}
+		}
+		if unit["input_traffic_control_profile"].(string) != "" {
+			configSet = append(configSet, setPrefixUnit+" input-traffic-control-profile "+
+				unit["input_traffic_control_profile"].(string))
+		}
+		if unit["output_traffic_control_profile"].(string) != "" {
+			configSet = append(configSet, setPrefixUnit+" output-traffic-control-profile "+
+				unit["output_traffic_control_profile"].(string))
+		}
+		if unit["scheduler_map"].(string) != "" {
+			configSet = append(configSet, setPrefixUnit+" scheduler-map "+unit["scheduler_map"].(string))
+		}
+		if unit["shaping_rate"].(string) != "" {
+			configSet = append(configSet, setPrefixUnit+" shaping-rate "+unit["shaping_rate"].(string))
+		}
+		if len(configSet) == configSetLenBeforeUnit {
+			configSet = append(configSet, setPrefixUnit)
+		}
+	}
+	if len(configSet) == 0 {
+		return fmt.Errorf("at least one of arguments need to be set for class-of-service interface %s",
+			d.Get("name").(string))
+	}
+
+	if err := sess.configSet(configSet, jnprSess); err != nil {
+		return err
+	}
+
+	return nil
+}
+func setClassOfServiceInterfaceCodePoints(setPrefix string,
+	configSet []string, codePoints map[string]interface{}) []string {
+	if codePoints["dscp"].(string) != "" {
+		configSet = append(configSet, setPrefix+"dscp "+codePoints["dscp"].(string))
+	}
+	if codePoints["dscp_ipv6"].(string) != "" {
+		configSet = append(configSet, setPrefix+"dscp-ipv6 "+codePoints["dscp_ipv6"].(string))
+	}
+	if codePoints["exp"].(string) != "" {
+		configSet = append(configSet, setPrefix+"exp "+codePoints["exp"].(string))
+	}
+	if codePoints["ieee_802_1"].(string) != "" {
+		configSet = append(configSet, setPrefix+"ieee-802.1 "+codePoints["ieee_802_1"].(string))
+	}
+	if codePoints["inet_precedence"].(string) != "" {
+		configSet = append(configSet, setPrefix+"inet-precedence "+codePoints["inet_precedence"].(string))
+	}
+
+	return configSet
+}
+func readClassOfServiceInterface(interFace string, m interface{}, jnprSess *NetconfObject) (
+	cosInterfaceOptions, error) {
+	sess := m.(*Session)
+	var confRead cosInterfaceOptions
+
+	cosInterfaceConfig, err := sess.command("show configuration"+
+		" class-of-service interfaces "+interFace+" | display set relative", jnprSess)
+	if err != nil {
+		return confRead, err
+	}
+	if cosInterfaceConfig != emptyWord {
+		confRead.name = interFace
+		for _, item := range strings.Split(cosInterfaceConfig, "\n") {
+			if strings.Contains(item, "<configuration-output>") {
+				continue
+			}
+			if strings.Contains(item, "</configuration-output>") {
+				break
+			}
+			itemTrim := strings.TrimPrefix(item, setLineStart)
+			switch {
+			case strings.HasPrefix(itemTrim, "input-traffic-control-profile "):
+				confRead.inputTrafficControlProfile = strings.TrimPrefix(itemTrim, "input-traffic-control-profile ")
+			case strings.HasPrefix(itemTrim, "output-traffic-control-profile "):
+				confRead.outputTrafficControlProfile = strings.TrimPrefix(itemTrim, "output-traffic-control-profile ")
+			case strings.HasPrefix(itemTrim, "scheduler-map "):
+				confRead.schedulerMap = strings.TrimPrefix(itemTrim, "scheduler-map ")
+			case strings.HasPrefix(itemTrim, "shaping-rate "):
+				confRead.shapingRate = strings.TrimPrefix(itemTrim, "shaping-rate ")
+			case strings.HasPrefix(itemTrim, "unit "):
+				unitSplit := strings.Split(strings.TrimPrefix(itemTrim, "unit "), " ")
+				unitOptions := map[string]interface{}{
+					"name":                           unitSplit[0],
+					"classifiers":                    make([]map[string]interface{}, 0),
+					"rewrite_rules":                  make([]map[string]interface{}, 0),
+					"input_traffic_control_profile":  "",
+					"output_traffic_control_profile": "",
+					"scheduler_map":                  "",
+					"shaping_rate":                   "",
+				}
+				itemTrimUnit := strings.TrimPrefix(itemTrim, "unit "+unitSplit[0]+" ")
+				if len(confRead.unit) > 0 {
+					unitOptions, confRead.unit = copyAndRemoveItemMapList("name", false, unitOptions, confRead.unit)
+				}
+				switch {
+				case strings.HasPrefix(itemTrimUnit, "classifiers "):
+					unitOptions["classifiers"] = readClassOfServiceInterfaceCodePoints(
+						strings.TrimPrefix(itemTrimUnit, "classifiers "),
+						unitOptions["classifiers"].([]map[string]interface{}))
+				case strings.HasPrefix(itemTrimUnit, "rewrite-rules "):
+					unitOptions["rewrite_rules"] = readClassOfServiceInterfaceCodePoints(
+						strings.TrimPrefix(itemTrimUnit, "rewrite-rules "),
+						unitOptions["rewrite_rules"].([]map[string]interface{}))
+				case strings.HasPrefix(itemTrimUnit, "input-traffic-control-profile "):
+					unitOptions["input_traffic_control_profile"] = strings.TrimPrefix(itemTrimUnit,
+						"input-traffic-control-profile ")
+				case strings.HasPrefix(itemTrimUnit, "output-traffic-control-profile "):
+					unitOptions["output_traffic_control_profile"] = strings.TrimPrefix(itemTrimUnit,
+						"output-traffic-control-profile ")
+				case strings.HasPrefix(itemTrimUnit, "scheduler-map "):
+					unitOptions["scheduler_map"] = strings.TrimPrefix(itemTrimUnit, "scheduler-map ")
+				case strings.HasPrefix(itemTrimUnit, "shaping-rate "):
+					unitOptions["shaping_rate"] = strings.TrimPrefix(itemTrimUnit, "shaping-rate ")
+				}
+				confRead.unit = append(confRead.unit, unitOptions)
+			}
+		}
+	} else {
+		confRead.name = ""
+
+		return confRead, nil
+	}
+
+	return confRead, nil
+}
+func readClassOfServiceInterfaceCodePoints(item string,
+	confReadElement []map[string]interface{}) []map[string]interface{} {
+	codePoints := map[string]interface{}{
+		"dscp":            "",
+		"dscp_ipv6":       "",
+		"exp":             "",
+		"ieee_802_1":      "",
+		"inet_precedence": "",
+	}
+	if len(confReadElement) > 0 {
+		for k, v := range confReadElement[0] {
+			codePoints[k] = v
+		}
+	}
+	switch {
+	case strings.HasPrefix(item, "dscp "):
+		codePoints["dscp"] = strings.TrimPrefix(item, "dscp ")
+	case strings.HasPrefix(item, "dscp-ipv6 "):
+		codePoints["dscp_ipv6"] = strings.TrimPrefix(item, "dscp-ipv6 ")
+	case strings.HasPrefix(item, "exp "):
+		codePoints["exp"] = strings.TrimPrefix(item, "exp ")
+	case strings.HasPrefix(item, "ieee-802.1 "):
+		codePoints["ieee_802_1"] = strings.TrimPrefix(item, "ieee-802.1 ")
+	case strings.HasPrefix(item, "inet-precedence "):
+		codePoints["inet_precedence"] = strings.TrimPrefix(item, "inet-precedence ")
+	}
+
+	// override (maxItem = 1)
+	return []map[string]interface{}{codePoints}
+}
+
+func delClassOfServiceInterface(interFace string, m interface{}, jnprSess *NetconfObject) error {
+	sess := m.(*Session)
+	configSet := make([]string, 0, 1)
+	configSet = append(configSet, "delete class-of-service interfaces "+interFace)
+	if err := sess.configSet(configSet, jnprSess); err != nil {
+		return err
+	}
+
+	return nil
+}
+func fillClassOfServiceInterfaceData(d *schema.ResourceData, cosInterfaceOptions cosInterfaceOptions) {
+	if tfErr := d.Set("name", cosInterfaceOptions.name); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("input_traffic_control_profile", cosInterfaceOptions.inputTrafficControlProfile); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("output_traffic_control_profile", cosInterfaceOptions.outputTrafficControlProfile); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("scheduler_map", cosInterfaceOptions.schedulerMap); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("shaping_rate", cosInterfaceOptions.shapingRate); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("unit", cosInterfaceOptions.unit); tfErr != nil {
+		panic(tfErr)
+	}
+}
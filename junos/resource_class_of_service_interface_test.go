@@ -0,0 +1,100 @@
+package junos_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJunosClassOfServiceInterface_basic(t *testing.T) {
+	var testaccInterface string
+	if os.Getenv("TESTACC_INTERFACE") != "" {
+		testaccInterface = os.Getenv("TESTACC_INTERFACE")
+	} else {
+		testaccInterface = defaultInterfaceTestAcc
+	}
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccJunosClassOfServiceInterfaceConfigCreate(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_class_of_service_interface.testacc_cosInterface",
+							"shaping_rate", "100m"),
+						resource.TestCheckResourceAttr("junos_class_of_service_interface.testacc_cosInterface",
+							"unit.#", "1"),
+						resource.TestCheckResourceAttr("junos_class_of_service_interface.testacc_cosInterface",
+							"unit.0.name", "0"),
+						resource.TestCheckResourceAttr("junos_class_of_service_interface.testacc_cosInterface",
+							"unit.0.classifiers.#", "1"),
+						resource.TestCheckResourceAttr("junos_class_of_service_interface.testacc_cosInterface",
+							"unit.0.classifiers.0.dscp", "default"),
+					),
+				},
+				{
+					Config: testAccJunosClassOfServiceInterfaceConfigUpdate(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_class_of_service_interface.testacc_cosInterface",
+							"shaping_rate", ""),
+						resource.TestCheckResourceAttr("junos_class_of_service_interface.testacc_cosInterface",
+							"unit.#", "2"),
+						resource.TestCheckResourceAttr("junos_class_of_service_interface.testacc_cosInterface",
+							"unit.0.rewrite_rules.#", "1"),
+						resource.TestCheckResourceAttr("junos_class_of_service_interface.testacc_cosInterface",
+							"unit.0.rewrite_rules.0.dscp", "default"),
+						resource.TestCheckResourceAttr("junos_class_of_service_interface.testacc_cosInterface",
+							"unit.0.rewrite_rules.0.inet_precedence", "default"),
+						resource.TestCheckResourceAttr("junos_class_of_service_interface.testacc_cosInterface",
+							"unit.1.name", "100"),
+						resource.TestCheckResourceAttr("junos_class_of_service_interface.testacc_cosInterface",
+							"unit.1.shaping_rate", "10m"),
+					),
+				},
+				{
+					ResourceName:      "junos_class_of_service_interface.testacc_cosInterface",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	}
+}
+
+func testAccJunosClassOfServiceInterfaceConfigCreate(interFace string) string {
+	return `
+resource junos_class_of_service_interface testacc_cosInterface {
+  name         = "` + interFace + `"
+  shaping_rate = "100m"
+  unit {
+    name = "0"
+    classifiers {
+      dscp = "default"
+    }
+  }
+}
+`
+}
+func testAccJunosClassOfServiceInterfaceConfigUpdate(interFace string) string {
+	return `
+resource junos_class_of_service_interface testacc_cosInterface {
+  name = "` + interFace + `"
+  unit {
+    name = "0"
+    classifiers {
+      dscp = "default"
+    }
+    rewrite_rules {
+      dscp            = "default"
+      inet_precedence = "default"
+    }
+  }
+  unit {
+    name         = "100"
+    shaping_rate = "10m"
+  }
+}
+`
+}
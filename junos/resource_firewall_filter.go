@@ -165,6 +165,16 @@ func resourceFirewallFilter() *schema.Resource {
 										Optional: true,
 										Elem:     &schema.Schema{Type: schema.TypeString},
 									},
+									"dscp": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"dscp_except": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
 									"tcp_flags": {
 										Type:     schema.TypeString,
 										Optional: true,
@@ -228,6 +238,16 @@ func resourceFirewallFilter() *schema.Resource {
 										Optional:         true,
 										ValidateDiagFunc: validateNameObjectJunos([]string{}),
 									},
+									"forwarding_class": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: validateNameObjectJunos([]string{}),
+									},
+									"loss_priority": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"low", "medium-low", "medium-high", "high"}, false),
+									},
 									"log": {
 										Type:     schema.TypeBool,
 										Optional: true,
@@ -433,7 +453,8 @@ func setFirewallFilter(d *schema.ResourceData, m interface{}, jnprSess *NetconfO
 		}
 
 		for _, from := range termMap["from"].([]interface{}) {
-			configSet, err = setFirewallFilterOptsFrom(setPrefixTerm+" from ", configSet, from.(map[string]interface{}))
+			configSet, err = setFirewallFilterOptsFrom(setPrefixTerm+" from ", d.Get("family").(string),
+				configSet, from.(map[string]interface{}))
 			if err != nil {
 				return err
 			}
@@ -531,7 +552,7 @@ func fillFirewallFilterData(d *schema.ResourceData, filterOptions filterOptions)
 	}
 }
 
-func setFirewallFilterOptsFrom(setPrefixTermFrom string,
+func setFirewallFilterOptsFrom(setPrefixTermFrom, family string,
 	configSet []string, fromMap map[string]interface{}) ([]string, error) {
 	for _, address := range fromMap["address"].([]interface{}) {
 		err := validateCIDRNetwork(address.(string))
@@ -630,6 +651,20 @@ func setFirewallFilterOptsFrom(setPrefixTermFrom string,
 	for _, protocol := range fromMap["protocol_except"].([]interface{}) {
 		configSet = append(configSet, setPrefixTermFrom+"protocol-except "+protocol.(string))
 	}
+	if len(fromMap["dscp"].([]interface{})) > 0 && len(fromMap["dscp_except"].([]interface{})) > 0 {
+		return configSet, fmt.Errorf("conflict between dscp and dscp_except")
+	}
+	// for family inet6, dscp is matched with traffic-class
+	dscpWord := "dscp"
+	if family == inet6Word {
+		dscpWord = "traffic-class"
+	}
+	for _, dscp := range fromMap["dscp"].([]interface{}) {
+		configSet = append(configSet, setPrefixTermFrom+dscpWord+" "+dscp.(string))
+	}
+	for _, dscp := range fromMap["dscp_except"].([]interface{}) {
+		configSet = append(configSet, setPrefixTermFrom+dscpWord+"-except "+dscp.(string))
+	}
 	if fromMap["tcp_flags"].(string) != "" && (fromMap["tcp_initial"].(bool) || fromMap["tcp_established"].(bool)) {
 		return configSet, fmt.Errorf("conflict between tcp_flags and tcp_initial|tcp_established")
 	}
@@ -679,6 +714,12 @@ func setFirewallFilterOptsThen(setPrefixTermThen string, configSet []string, the
 	if thenMap["policer"].(string) != "" {
 		configSet = append(configSet, setPrefixTermThen+"policer "+thenMap["policer"].(string))
 	}
+	if thenMap["forwarding_class"].(string) != "" {
+		configSet = append(configSet, setPrefixTermThen+"forwarding-class "+thenMap["forwarding_class"].(string))
+	}
+	if thenMap["loss_priority"].(string) != "" {
+		configSet = append(configSet, setPrefixTermThen+"loss-priority "+thenMap["loss_priority"].(string))
+	}
 	if thenMap["log"].(bool) {
 		configSet = append(configSet, setPrefixTermThen+"log")
 	}
@@ -777,6 +818,16 @@ func readFirewallFilterOptsFrom(item string,
 	case strings.HasPrefix(item, "protocol-except "):
 		fromMap["protocol_except"] = append(fromMap["protocol_except"].([]string),
 			strings.TrimPrefix(item, "protocol-except "))
+	case strings.HasPrefix(item, "dscp "):
+		fromMap["dscp"] = append(fromMap["dscp"].([]string), strings.TrimPrefix(item, "dscp "))
+	case strings.HasPrefix(item, "dscp-except "):
+		fromMap["dscp_except"] = append(fromMap["dscp_except"].([]string),
+			strings.TrimPrefix(item, "dscp-except "))
+	case strings.HasPrefix(item, "traffic-class "):
+		fromMap["dscp"] = append(fromMap["dscp"].([]string), strings.TrimPrefix(item, "traffic-class "))
+	case strings.HasPrefix(item, "traffic-class-except "):
+		fromMap["dscp_except"] = append(fromMap["dscp_except"].([]string),
+			strings.TrimPrefix(item, "traffic-class-except "))
 	case strings.HasPrefix(item, "tcp-flags "):
 		fromMap["tcp_flags"] = strings.Trim(strings.TrimPrefix(item, "tcp-flags "), "\"")
 	case strings.HasSuffix(item, "tcp-initial"):
@@ -810,6 +861,10 @@ func readFirewallFilterOptsThen(item string,
 		}
 	}
 	switch {
+	case strings.HasPrefix(item, "forwarding-class "):
+		thenMap["forwarding_class"] = strings.TrimPrefix(item, "forwarding-class ")
+	case strings.HasPrefix(item, "loss-priority "):
+		thenMap["loss_priority"] = strings.TrimPrefix(item, "loss-priority ")
 	case strings.HasSuffix(item, "accept"),
 		strings.HasSuffix(item, "reject"),
 		strings.HasSuffix(item, "discard"),
@@ -858,6 +913,8 @@ func genMapFirewallFilterOptsFrom() map[string]interface{} {
 		"source_prefix_list_except":      make([]string, 0),
 		"protocol":                       make([]string, 0),
 		"protocol_except":                make([]string, 0),
+		"dscp":                           make([]string, 0),
+		"dscp_except":                    make([]string, 0),
 		"tcp_flags":                      "",
 		"tcp_initial":                    false,
 		"tcp_established":                false,
@@ -874,6 +931,8 @@ func genMapFirewallFilterOptsThen() map[string]interface{} {
 		"count":              "",
 		"routing_instance":   "",
 		"policer":            "",
+		"forwarding_class":   "",
+		"loss_priority":      "",
 		"log":                false,
 		"syslog":             false,
 		"port_mirror":        false,
@@ -72,7 +72,7 @@ func TestAccJunosFirewallFilter_basic(t *testing.T) {
 					Config: testAccJunosFirewallFilterConfigUpdate(),
 					Check: resource.ComposeTestCheckFunc(
 						resource.TestCheckResourceAttr("junos_firewall_filter.testacc_fwFilter",
-							"term.#", "4"),
+							"term.#", "5"),
 						resource.TestCheckResourceAttr("junos_firewall_filter.testacc_fwFilter",
 							"term.1.from.#", "1"),
 						resource.TestCheckResourceAttr("junos_firewall_filter.testacc_fwFilter",
@@ -125,10 +125,20 @@ func TestAccJunosFirewallFilter_basic(t *testing.T) {
 							"term.3.then.#", "1"),
 						resource.TestCheckResourceAttr("junos_firewall_filter.testacc_fwFilter",
 							"term.3.then.0.action", "reject"),
+						resource.TestCheckResourceAttr("junos_firewall_filter.testacc_fwFilter",
+							"term.4.from.0.dscp.#", "2"),
+						resource.TestCheckResourceAttr("junos_firewall_filter.testacc_fwFilter",
+							"term.4.from.0.dscp.0", "af11"),
+						resource.TestCheckResourceAttr("junos_firewall_filter.testacc_fwFilter",
+							"term.4.then.0.forwarding_class", "best-effort"),
+						resource.TestCheckResourceAttr("junos_firewall_filter.testacc_fwFilter",
+							"term.4.then.0.loss_priority", "high"),
+						resource.TestCheckResourceAttr("junos_firewall_filter.testacc_fwFilter",
+							"term.4.then.0.action", "accept"),
 						resource.TestCheckResourceAttr("junos_firewall_filter.testacc_fwFilter6",
 							"family", "inet6"),
 						resource.TestCheckResourceAttr("junos_firewall_filter.testacc_fwFilter6",
-							"term.#", "1"),
+							"term.#", "2"),
 						resource.TestCheckResourceAttr("junos_firewall_filter.testacc_fwFilter6",
 							"term.0.from.#", "1"),
 						resource.TestCheckResourceAttr("junos_firewall_filter.testacc_fwFilter6",
@@ -139,6 +149,12 @@ func TestAccJunosFirewallFilter_basic(t *testing.T) {
 							"term.0.then.#", "1"),
 						resource.TestCheckResourceAttr("junos_firewall_filter.testacc_fwFilter6",
 							"term.0.then.0.action", "discard"),
+						resource.TestCheckResourceAttr("junos_firewall_filter.testacc_fwFilter6",
+							"term.1.from.0.dscp.#", "1"),
+						resource.TestCheckResourceAttr("junos_firewall_filter.testacc_fwFilter6",
+							"term.1.from.0.dscp.0", "af11"),
+						resource.TestCheckResourceAttr("junos_firewall_filter.testacc_fwFilter6",
+							"term.1.from.0.dscp_except.#", "0"),
 					),
 				},
 				{
@@ -254,6 +270,17 @@ resource junos_firewall_filter "testacc_fwFilter" {
       action = "reject"
     }
   }
+  term {
+    name = "testacc_fwFilter_term5"
+    from {
+      dscp = [ "af11", "af12" ]
+    }
+    then {
+      forwarding_class = "best-effort"
+      loss_priority = "high"
+      action = "accept"
+    }
+  }
 }
 resource junos_firewall_filter "testacc_fwFilter6" {
   name = "testacc_fwFilter6"
@@ -267,6 +294,16 @@ resource junos_firewall_filter "testacc_fwFilter6" {
       action = "discard"
     }
   }
+  term {
+    name = "testacc_fwFilter6_term2"
+    from {
+      dscp = ["af11"]
+    }
+    then {
+      forwarding_class = "best-effort"
+      action           = "accept"
+    }
+  }
 }
 resource junos_policyoptions_prefix_list "testacc_fwFilter" {
   name = "testacc_fwFilter"
@@ -0,0 +1,537 @@
+package junos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type dhcpRelayOptions struct {
+	forwardOnly       bool
+	activeServerGroup string
+	routingInstance   string
+	group             []map[string]interface{}
+	relayOption82     []map[string]interface{}
+	serverGroup       []map[string]interface{}
+}
+
+func resourceForwardingOptionsDhcpRelay() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceForwardingOptionsDhcpRelayCreate,
+		ReadContext:   resourceForwardingOptionsDhcpRelayRead,
+		UpdateContext: resourceForwardingOptionsDhcpRelayUpdate,
+		DeleteContext: resourceForwardingOptionsDhcpRelayDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceForwardingOptionsDhcpRelayImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"routing_instance": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          defaultWord,
+				ValidateDiagFunc: validateNameObjectJunos([]string{}),
+			},
+			"active_server_group": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateNameObjectJunos([]string{}),
+			},
+			"forward_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"group": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateNameObjectJunos([]string{}),
+						},
+						"active_server_group": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateNameObjectJunos([]string{}),
+						},
+						"forward_only": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"interface": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"relay_option_82": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: genSchemaForwardingOptionsDhcpRelayOption82(),
+							},
+						},
+					},
+				},
+			},
+			"relay_option_82": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: genSchemaForwardingOptionsDhcpRelayOption82(),
+				},
+			},
+			"server_group": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateNameObjectJunos([]string{}),
+						},
+						"ip_address": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsIPAddress,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func genSchemaForwardingOptionsDhcpRelayOption82() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"circuit_id": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"remote_id": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"server_id_override": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+	}
+}
+
+func resourceForwardingOptionsDhcpRelayCreate(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	if d.Get("routing_instance").(string) != defaultWord {
+		instanceExists, err := checkRoutingInstanceExists(d.Get("routing_instance").(string), m, jnprSess)
+		if err != nil {
+			sess.configClear(jnprSess)
+
+			return diag.FromErr(err)
+		}
+		if !instanceExists {
+			sess.configClear(jnprSess)
+
+			return diag.FromErr(fmt.Errorf("routing instance %v doesn't exist", d.Get("routing_instance").(string)))
+		}
+	}
+	dhcpRelayExists, err := checkForwardingOptionsDhcpRelayExists(d.Get("routing_instance").(string), m, jnprSess)
+	if err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if dhcpRelayExists {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(fmt.Errorf("forwarding-options dhcp-relay already exists in routing_instance %v",
+			d.Get("routing_instance").(string)))
+	}
+
+	if err := setForwardingOptionsDhcpRelay(d, m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("create resource junos_forwardingoptions_dhcp_relay", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	dhcpRelayExists, err = checkForwardingOptionsDhcpRelayExists(d.Get("routing_instance").(string), m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if dhcpRelayExists {
+		d.SetId(d.Get("routing_instance").(string))
+	} else {
+		return diag.FromErr(fmt.Errorf("forwarding-options dhcp-relay not exists in routing_instance %v after commit "+
+			"=> check your config", d.Get("routing_instance").(string)))
+	}
+
+	return resourceForwardingOptionsDhcpRelayRead(ctx, d, m)
+}
+func resourceForwardingOptionsDhcpRelayRead(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	mutex.Lock()
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		mutex.Unlock()
+
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	dhcpRelayOptions, err := readForwardingOptionsDhcpRelay(d.Get("routing_instance").(string), m, jnprSess)
+	mutex.Unlock()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if dhcpRelayOptions.routingInstance == "" {
+		d.SetId("")
+	} else {
+		fillForwardingOptionsDhcpRelayData(d, dhcpRelayOptions)
+	}
+
+	return nil
+}
+func resourceForwardingOptionsDhcpRelayUpdate(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.Partial(true)
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	if err := delForwardingOptionsDhcpRelay(d.Get("routing_instance").(string), m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := setForwardingOptionsDhcpRelay(d, m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("update resource junos_forwardingoptions_dhcp_relay", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	d.Partial(false)
+
+	return resourceForwardingOptionsDhcpRelayRead(ctx, d, m)
+}
+func resourceForwardingOptionsDhcpRelayDelete(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	if err := delForwardingOptionsDhcpRelay(d.Get("routing_instance").(string), m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("delete resource junos_forwardingoptions_dhcp_relay", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+func resourceForwardingOptionsDhcpRelayImport(
+	d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer sess.closeSession(jnprSess)
+	result := make([]*schema.ResourceData, 1)
+
+	dhcpRelayExists, err := checkForwardingOptionsDhcpRelayExists(d.Id(), m, jnprSess)
+	if err != nil {
+		return nil, err
+	}
+	if !dhcpRelayExists {
+		return nil, fmt.Errorf("don't find forwarding-options dhcp-relay with id '%v' "+
+			"(id must be <routing_instance>)", d.Id())
+	}
+	dhcpRelayOptions, err := readForwardingOptionsDhcpRelay(d.Id(), m, jnprSess)
+	if err != nil {
+		return nil, err
+	}
+	fillForwardingOptionsDhcpRelayData(d, dhcpRelayOptions)
+
+	result[0] = d
+
+	return result, nil
+}
+
+func checkForwardingOptionsDhcpRelayExists(instance string, m interface{}, jnprSess *NetconfObject) (bool, error) {
+	sess := m.(*Session)
+	var dhcpRelayConfig string
+	var err error
+	if instance == defaultWord {
+		dhcpRelayConfig, err = sess.command("show configuration"+
+			" forwarding-options dhcp-relay | display set", jnprSess)
+		if err != nil {
+			return false, err
+		}
+	} else {
+		dhcpRelayConfig, err = sess.command("show configuration routing-instances "+instance+
+			" forwarding-options dhcp-relay | display set", jnprSess)
+		if err != nil {
+			return false, err
+		}
+	}
+	if dhcpRelayConfig == emptyWord {
+		return false, nil
+	}
+
+	return true, nil
+}
+func setForwardingOptionsDhcpRelay(d *schema.ResourceData, m interface{}, jnprSess *NetconfObject) error {
+	sess := m.(*Session)
+
+	setPrefix := "set forwarding-options dhcp-relay "
+	if d.Get("routing_instance").(string) != defaultWord {
+		setPrefix = "set routing-instances " + d.Get("routing_instance").(string) + " forwarding-options dhcp-relay "
+	}
+	configSet := []string{strings.TrimSuffix(setPrefix, " ")}
+
+	if d.Get("active_server_group").(string) != "" {
+		configSet = append(configSet, setPrefix+"active-server-group "+d.Get("active_server_group").(string))
+	}
+	if d.Get("forward_only").(bool) {
+		configSet = append(configSet, setPrefix+"forward-only")
+	}
+	groupNameList := make([]string, 0)
+	for _, v := range d.Get("group").([]interface{}) {
+		group := v.(map[string]interface{})
+		if stringInSlice(group["name"].(string), groupNameList) {
+			return fmt.Errorf("multiple group blocks with the same name %s", group["name"].(string))
+		}
+		groupNameList = append(groupNameList, group["name"].(string))
+		setPrefixGroup := setPrefix + "group " + group["name"].(string) + " "
+		configSet = append(configSet, strings.TrimSuffix(setPrefixGroup, " "))
+		if group["active_server_group"].(string) != "" {
+			configSet = append(configSet, setPrefixGroup+"active-server-group "+group["active_server_group"].(string))
+		}
+		if group["forward_only"].(bool) {
+			configSet = append(configSet, setPrefixGroup+"forward-only")
+		}
+		for _, v2 := range group["interface"].([]interface{}) {
+			configSet = append(configSet, setPrefixGroup+"interface "+v2.(string))
+		}
+		for _, v2 := range group["relay_option_82"].([]interface{}) {
+			configSet = setForwardingOptionsDhcpRelayOption82(setPrefixGroup+"relay-option-82", configSet, v2)
+		}
+	}
+	for _, v := range d.Get("relay_option_82").([]interface{}) {
+		configSet = setForwardingOptionsDhcpRelayOption82(setPrefix+"relay-option-82", configSet, v)
+	}
+	serverGroupNameList := make([]string, 0)
+	for _, v := range d.Get("server_group").([]interface{}) {
+		serverGroup := v.(map[string]interface{})
+		if stringInSlice(serverGroup["name"].(string), serverGroupNameList) {
+			return fmt.Errorf("multiple server_group blocks with the same name %s", serverGroup["name"].(string))
+		}
+		serverGroupNameList = append(serverGroupNameList, serverGroup["name"].(string))
+		configSet = append(configSet, setPrefix+"server-group "+serverGroup["name"].(string))
+		for _, v2 := range serverGroup["ip_address"].([]interface{}) {
+			configSet = append(configSet, setPrefix+"server-group "+serverGroup["name"].(string)+" "+v2.(string))
+		}
+	}
+
+	if err := sess.configSet(configSet, jnprSess); err != nil {
+		return err
+	}
+
+	return nil
+}
+func setForwardingOptionsDhcpRelayOption82(setPrefix string, configSet []string, option82 interface{}) []string {
+	configSet = append(configSet, setPrefix)
+	if option82 != nil {
+		option82M := option82.(map[string]interface{})
+		if option82M["circuit_id"].(bool) {
+			configSet = append(configSet, setPrefix+" circuit-id")
+		}
+		if option82M["remote_id"].(bool) {
+			configSet = append(configSet, setPrefix+" remote-id")
+		}
+		if option82M["server_id_override"].(bool) {
+			configSet = append(configSet, setPrefix+" server-id-override")
+		}
+	}
+
+	return configSet
+}
+func readForwardingOptionsDhcpRelay(instance string, m interface{}, jnprSess *NetconfObject) (
+	dhcpRelayOptions, error) {
+	sess := m.(*Session)
+	var confRead dhcpRelayOptions
+	var dhcpRelayConfig string
+	var err error
+
+	if instance == defaultWord {
+		dhcpRelayConfig, err = sess.command("show configuration"+
+			" forwarding-options dhcp-relay | display set relative", jnprSess)
+		if err != nil {
+			return confRead, err
+		}
+	} else {
+		dhcpRelayConfig, err = sess.command("show configuration routing-instances "+instance+
+			" forwarding-options dhcp-relay | display set relative", jnprSess)
+		if err != nil {
+			return confRead, err
+		}
+	}
+	if dhcpRelayConfig != emptyWord {
+		confRead.routingInstance = instance
+		for _, item := range strings.Split(dhcpRelayConfig, "\n") {
+			if strings.Contains(item, "<configuration-output>") {
+				continue
+			}
+			if strings.Contains(item, "</configuration-output>") {
+				break
+			}
+			itemTrim := strings.TrimPrefix(item, setLineStart)
+			switch {
+			case strings.HasPrefix(itemTrim, "active-server-group "):
+				confRead.activeServerGroup = strings.TrimPrefix(itemTrim, "active-server-group ")
+			case itemTrim == "forward-only":
+				confRead.forwardOnly = true
+			case strings.HasPrefix(itemTrim, "group "):
+				groupLineCut := strings.Split(strings.TrimPrefix(itemTrim, "group "), " ")
+				group := map[string]interface{}{
+					"name":                groupLineCut[0],
+					"active_server_group": "",
+					"forward_only":        false,
+					"interface":           make([]string, 0),
+					"relay_option_82":     make([]map[string]interface{}, 0),
+				}
+				group, confRead.group = copyAndRemoveItemMapList("name", false, group, confRead.group)
+				itemTrimGroup := strings.TrimPrefix(itemTrim, "group "+groupLineCut[0]+" ")
+				switch {
+				case strings.HasPrefix(itemTrimGroup, "active-server-group "):
+					group["active_server_group"] = strings.TrimPrefix(itemTrimGroup, "active-server-group ")
+				case itemTrimGroup == "forward-only":
+					group["forward_only"] = true
+				case strings.HasPrefix(itemTrimGroup, "interface "):
+					group["interface"] = append(group["interface"].([]string),
+						strings.TrimPrefix(itemTrimGroup, "interface "))
+				case strings.HasPrefix(itemTrimGroup, "relay-option-82"):
+					group["relay_option_82"] = readForwardingOptionsDhcpRelayOption82(
+						strings.TrimPrefix(itemTrimGroup, "relay-option-82"),
+						group["relay_option_82"].([]map[string]interface{}))
+				}
+				confRead.group = append(confRead.group, group)
+			case strings.HasPrefix(itemTrim, "relay-option-82"):
+				confRead.relayOption82 = readForwardingOptionsDhcpRelayOption82(
+					strings.TrimPrefix(itemTrim, "relay-option-82"), confRead.relayOption82)
+			case strings.HasPrefix(itemTrim, "server-group "):
+				serverGroupLineCut := strings.Split(strings.TrimPrefix(itemTrim, "server-group "), " ")
+				serverGroup := map[string]interface{}{
+					"name":       serverGroupLineCut[0],
+					"ip_address": make([]string, 0),
+				}
+				serverGroup, confRead.serverGroup = copyAndRemoveItemMapList("name", false,
+					serverGroup, confRead.serverGroup)
+				if len(serverGroupLineCut) > 1 {
+					serverGroup["ip_address"] = append(serverGroup["ip_address"].([]string), serverGroupLineCut[1])
+				}
+				confRead.serverGroup = append(confRead.serverGroup, serverGroup)
+			}
+		}
+	} else {
+		confRead.routingInstance = ""
+
+		return confRead, nil
+	}
+
+	return confRead, nil
+}
+func readForwardingOptionsDhcpRelayOption82(item string,
+	confReadElement []map[string]interface{}) []map[string]interface{} {
+	option82 := map[string]interface{}{
+		"circuit_id":         false,
+		"remote_id":          false,
+		"server_id_override": false,
+	}
+	if len(confReadElement) > 0 {
+		for k, v := range confReadElement[0] {
+			option82[k] = v
+		}
+	}
+	switch {
+	case strings.HasPrefix(item, " circuit-id"):
+		option82["circuit_id"] = true
+	case strings.HasPrefix(item, " remote-id"):
+		option82["remote_id"] = true
+	case strings.HasPrefix(item, " server-id-override"):
+		option82["server_id_override"] = true
+	}
+
+	// override (maxItem = 1)
+	return []map[string]interface{}{option82}
+}
+
+func delForwardingOptionsDhcpRelay(instance string, m interface{}, jnprSess *NetconfObject) error {
+	sess := m.(*Session)
+	configSet := make([]string, 0, 1)
+	if instance == defaultWord {
+		configSet = append(configSet, "delete forwarding-options dhcp-relay")
+	} else {
+		configSet = append(configSet, "delete routing-instances "+instance+" forwarding-options dhcp-relay")
+	}
+	if err := sess.configSet(configSet, jnprSess); err != nil {
+		return err
+	}
+
+	return nil
+}
+func fillForwardingOptionsDhcpRelayData(d *schema.ResourceData, dhcpRelayOptions dhcpRelayOptions) {
+	if tfErr := d.Set("routing_instance", dhcpRelayOptions.routingInstance); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("active_server_group", dhcpRelayOptions.activeServerGroup); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("forward_only", dhcpRelayOptions.forwardOnly); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("group", dhcpRelayOptions.group); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("relay_option_82", dhcpRelayOptions.relayOption82); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("server_group", dhcpRelayOptions.serverGroup); tfErr != nil {
+		panic(tfErr)
+	}
+}
@@ -0,0 +1,126 @@
+package junos_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJunosForwardingOptionsDhcpRelay_basic(t *testing.T) {
+	var testaccInterface string
+	if os.Getenv("TESTACC_INTERFACE") != "" {
+		testaccInterface = os.Getenv("TESTACC_INTERFACE")
+	} else {
+		testaccInterface = defaultInterfaceTestAcc
+	}
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccJunosForwardingOptionsDhcpRelayConfigCreate(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_forwardingoptions_dhcp_relay.testacc_dhcpRelay",
+							"routing_instance", "default"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_dhcp_relay.testacc_dhcpRelay",
+							"active_server_group", "testacc_dhcpRelay"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_dhcp_relay.testacc_dhcpRelay",
+							"server_group.#", "1"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_dhcp_relay.testacc_dhcpRelay",
+							"server_group.0.ip_address.#", "2"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_dhcp_relay.testacc_dhcpRelay",
+							"group.#", "1"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_dhcp_relay.testacc_dhcpRelay",
+							"group.0.interface.#", "1"),
+					),
+				},
+				{
+					Config: testAccJunosForwardingOptionsDhcpRelayConfigUpdate(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_forwardingoptions_dhcp_relay.testacc_dhcpRelay",
+							"forward_only", "true"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_dhcp_relay.testacc_dhcpRelay",
+							"relay_option_82.#", "1"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_dhcp_relay.testacc_dhcpRelay",
+							"relay_option_82.0.circuit_id", "true"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_dhcp_relay.testacc_dhcpRelay",
+							"group.0.active_server_group", "testacc_dhcpRelay2"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_dhcp_relay.testacc_dhcpRelay",
+							"group.0.relay_option_82.0.remote_id", "true"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_dhcp_relay.testacc_dhcpRelay_ri",
+							"routing_instance", "testacc_dhcpRelay"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_dhcp_relay.testacc_dhcpRelay_ri",
+							"server_group.#", "1"),
+					),
+				},
+				{
+					ResourceName:      "junos_forwardingoptions_dhcp_relay.testacc_dhcpRelay",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+				{
+					ResourceName:      "junos_forwardingoptions_dhcp_relay.testacc_dhcpRelay_ri",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	}
+}
+
+func testAccJunosForwardingOptionsDhcpRelayConfigCreate(interFace string) string {
+	return `
+resource junos_forwardingoptions_dhcp_relay testacc_dhcpRelay {
+  active_server_group = "testacc_dhcpRelay"
+  server_group {
+    name       = "testacc_dhcpRelay"
+    ip_address = ["192.0.2.1", "192.0.2.2"]
+  }
+  group {
+    name      = "testacc_dhcpRelay"
+    interface = ["` + interFace + `.0"]
+  }
+}
+`
+}
+func testAccJunosForwardingOptionsDhcpRelayConfigUpdate(interFace string) string {
+	return `
+resource junos_forwardingoptions_dhcp_relay testacc_dhcpRelay {
+  active_server_group = "testacc_dhcpRelay"
+  forward_only        = true
+  relay_option_82 {
+    circuit_id = true
+  }
+  server_group {
+    name       = "testacc_dhcpRelay"
+    ip_address = ["192.0.2.1", "192.0.2.2"]
+  }
+  server_group {
+    name       = "testacc_dhcpRelay2"
+    ip_address = ["192.0.2.3"]
+  }
+  group {
+    name                = "testacc_dhcpRelay"
+    active_server_group = "testacc_dhcpRelay2"
+    interface           = ["` + interFace + `.0"]
+    relay_option_82 {
+      remote_id          = true
+      server_id_override = true
+    }
+  }
+}
+resource junos_routing_instance testacc_dhcpRelay {
+  name = "testacc_dhcpRelay"
+}
+resource junos_forwardingoptions_dhcp_relay testacc_dhcpRelay_ri {
+  routing_instance    = junos_routing_instance.testacc_dhcpRelay.name
+  active_server_group = "testacc_dhcpRelay_ri"
+  server_group {
+    name       = "testacc_dhcpRelay_ri"
+    ip_address = ["192.0.2.10"]
+  }
+}
+`
+}
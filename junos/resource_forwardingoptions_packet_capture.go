@@ -0,0 +1,347 @@
+package junos
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type packetCaptureOptions struct {
+	disable            bool
+	maximumCaptureSize int
+	file               []map[string]interface{}
+}
+
+func resourceForwardingOptionsPacketCapture() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceForwardingOptionsPacketCaptureCreate,
+		ReadContext:   resourceForwardingOptionsPacketCaptureRead,
+		UpdateContext: resourceForwardingOptionsPacketCaptureUpdate,
+		DeleteContext: resourceForwardingOptionsPacketCaptureDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceForwardingOptionsPacketCaptureImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"disable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"file": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filename": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"files": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(2, 10000),
+						},
+						"size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1024, 104857600),
+						},
+						"world_readable": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"maximum_capture_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(68, 1520),
+			},
+		},
+	}
+}
+
+func resourceForwardingOptionsPacketCaptureCreate(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	packetCaptureExists, err := checkForwardingOptionsPacketCaptureExists(m, jnprSess)
+	if err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if packetCaptureExists {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(fmt.Errorf("forwarding-options packet-capture already configured => use import"))
+	}
+
+	if err := setForwardingOptionsPacketCapture(d, m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("create resource junos_forwardingoptions_packet_capture", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+
+	d.SetId("packet_capture")
+
+	return resourceForwardingOptionsPacketCaptureRead(ctx, d, m)
+}
+func resourceForwardingOptionsPacketCaptureRead(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	mutex.Lock()
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		mutex.Unlock()
+
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	packetCaptureExists, err := checkForwardingOptionsPacketCaptureExists(m, jnprSess)
+	if err != nil {
+		mutex.Unlock()
+
+		return diag.FromErr(err)
+	}
+	if !packetCaptureExists {
+		mutex.Unlock()
+		d.SetId("")
+
+		return nil
+	}
+	packetCaptureOptions, err := readForwardingOptionsPacketCapture(m, jnprSess)
+	mutex.Unlock()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	fillForwardingOptionsPacketCaptureData(d, packetCaptureOptions)
+
+	return nil
+}
+func resourceForwardingOptionsPacketCaptureUpdate(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.Partial(true)
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	if err := delForwardingOptionsPacketCapture(m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := setForwardingOptionsPacketCapture(d, m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("update resource junos_forwardingoptions_packet_capture", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	d.Partial(false)
+
+	return resourceForwardingOptionsPacketCaptureRead(ctx, d, m)
+}
+func resourceForwardingOptionsPacketCaptureDelete(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	if err := delForwardingOptionsPacketCapture(m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("delete resource junos_forwardingoptions_packet_capture", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+func resourceForwardingOptionsPacketCaptureImport(
+	d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer sess.closeSession(jnprSess)
+	result := make([]*schema.ResourceData, 1)
+	packetCaptureExists, err := checkForwardingOptionsPacketCaptureExists(m, jnprSess)
+	if err != nil {
+		return nil, err
+	}
+	if !packetCaptureExists {
+		return nil, fmt.Errorf("don't find forwarding-options packet-capture configuration")
+	}
+	packetCaptureOptions, err := readForwardingOptionsPacketCapture(m, jnprSess)
+	if err != nil {
+		return nil, err
+	}
+	fillForwardingOptionsPacketCaptureData(d, packetCaptureOptions)
+	d.SetId("packet_capture")
+	result[0] = d
+
+	return result, nil
+}
+
+func checkForwardingOptionsPacketCaptureExists(m interface{}, jnprSess *NetconfObject) (bool, error) {
+	sess := m.(*Session)
+	packetCaptureConfig, err := sess.command("show configuration"+
+		" forwarding-options packet-capture | display set", jnprSess)
+	if err != nil {
+		return false, err
+	}
+	if packetCaptureConfig == emptyWord {
+		return false, nil
+	}
+
+	return true, nil
+}
+func setForwardingOptionsPacketCapture(d *schema.ResourceData, m interface{}, jnprSess *NetconfObject) error {
+	sess := m.(*Session)
+
+	setPrefix := "set forwarding-options packet-capture "
+	configSet := []string{strings.TrimSuffix(setPrefix, " ")}
+
+	if d.Get("disable").(bool) {
+		configSet = append(configSet, setPrefix+"disable")
+	}
+	for _, v := range d.Get("file").([]interface{}) {
+		if v != nil {
+			file := v.(map[string]interface{})
+			if file["filename"].(string) != "" {
+				configSet = append(configSet, setPrefix+"file filename "+file["filename"].(string))
+			}
+			if file["files"].(int) != 0 {
+				configSet = append(configSet, setPrefix+"file files "+strconv.Itoa(file["files"].(int)))
+			}
+			if file["size"].(int) != 0 {
+				configSet = append(configSet, setPrefix+"file size "+strconv.Itoa(file["size"].(int)))
+			}
+			if file["world_readable"].(bool) {
+				configSet = append(configSet, setPrefix+"file world-readable")
+			}
+		}
+	}
+	if d.Get("maximum_capture_size").(int) != 0 {
+		configSet = append(configSet, setPrefix+"maximum-capture-size "+
+			strconv.Itoa(d.Get("maximum_capture_size").(int)))
+	}
+
+	if err := sess.configSet(configSet, jnprSess); err != nil {
+		return err
+	}
+
+	return nil
+}
+func readForwardingOptionsPacketCapture(m interface{}, jnprSess *NetconfObject) (packetCaptureOptions, error) {
+	sess := m.(*Session)
+	var confRead packetCaptureOptions
+
+	packetCaptureConfig, err := sess.command("show configuration"+
+		" forwarding-options packet-capture | display set relative", jnprSess)
+	if err != nil {
+		return confRead, err
+	}
+	if packetCaptureConfig != emptyWord {
+		for _, item := range strings.Split(packetCaptureConfig, "\n") {
+			if strings.Contains(item, "<configuration-output>") {
+				continue
+			}
+			if strings.Contains(item, "</configuration-output>") {
+				break
+			}
+			itemTrim := strings.TrimPrefix(item, setLineStart)
+			switch {
+			case itemTrim == "disable":
+				confRead.disable = true
+			case strings.HasPrefix(itemTrim, "file "):
+				if len(confRead.file) == 0 {
+					confRead.file = append(confRead.file, map[string]interface{}{
+						"filename":       "",
+						"files":          0,
+						"size":           0,
+						"world_readable": false,
+					})
+				}
+				switch {
+				case strings.HasPrefix(itemTrim, "file filename "):
+					confRead.file[0]["filename"] = strings.Trim(strings.TrimPrefix(itemTrim, "file filename "), "\"")
+				case strings.HasPrefix(itemTrim, "file files "):
+					var err error
+					confRead.file[0]["files"], err = strconv.Atoi(strings.TrimPrefix(itemTrim, "file files "))
+					if err != nil {
+						return confRead, fmt.Errorf("failed to convert value from '%s' to integer : %w", itemTrim, err)
+					}
+				case strings.HasPrefix(itemTrim, "file size "):
+					var err error
+					confRead.file[0]["size"], err = convertSizeWithSuffix(strings.TrimPrefix(itemTrim, "file size "))
+					if err != nil {
+						return confRead, fmt.Errorf("failed to convert value from '%s' to integer : %w", itemTrim, err)
+					}
+				case itemTrim == "file world-readable":
+					confRead.file[0]["world_readable"] = true
+				}
+			case strings.HasPrefix(itemTrim, "maximum-capture-size "):
+				var err error
+				confRead.maximumCaptureSize, err = strconv.Atoi(strings.TrimPrefix(itemTrim, "maximum-capture-size "))
+				if err != nil {
+					return confRead, fmt.Errorf("failed to convert value from '%s' to integer : %w", itemTrim, err)
+				}
+			}
+		}
+	}
+
+	return confRead, nil
+}
+
+func delForwardingOptionsPacketCapture(m interface{}, jnprSess *NetconfObject) error {
+	sess := m.(*Session)
+	configSet := make([]string, 0, 1)
+	configSet = append(configSet, "delete forwarding-options packet-capture")
+	if err := sess.configSet(configSet, jnprSess); err != nil {
+		return err
+	}
+
+	return nil
+}
+func fillForwardingOptionsPacketCaptureData(d *schema.ResourceData, packetCaptureOptions packetCaptureOptions) {
+	if tfErr := d.Set("disable", packetCaptureOptions.disable); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("file", packetCaptureOptions.file); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("maximum_capture_size", packetCaptureOptions.maximumCaptureSize); tfErr != nil {
+		panic(tfErr)
+	}
+}
@@ -0,0 +1,74 @@
+package junos_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJunosForwardingOptionsPacketCapture_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccJunosForwardingOptionsPacketCaptureConfigCreate(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_forwardingoptions_packet_capture.testacc_packetCapture",
+							"disable", "true"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_packet_capture.testacc_packetCapture",
+							"file.#", "1"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_packet_capture.testacc_packetCapture",
+							"file.0.filename", "testacc_pcap"),
+					),
+				},
+				{
+					Config: testAccJunosForwardingOptionsPacketCaptureConfigUpdate(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_forwardingoptions_packet_capture.testacc_packetCapture",
+							"disable", "false"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_packet_capture.testacc_packetCapture",
+							"file.0.files", "10"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_packet_capture.testacc_packetCapture",
+							"file.0.size", "2048"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_packet_capture.testacc_packetCapture",
+							"file.0.world_readable", "true"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_packet_capture.testacc_packetCapture",
+							"maximum_capture_size", "500"),
+					),
+				},
+				{
+					ResourceName:      "junos_forwardingoptions_packet_capture.testacc_packetCapture",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	}
+}
+
+func testAccJunosForwardingOptionsPacketCaptureConfigCreate() string {
+	return `
+resource junos_forwardingoptions_packet_capture testacc_packetCapture {
+  disable = true
+  file {
+    filename = "testacc_pcap"
+  }
+}
+`
+}
+func testAccJunosForwardingOptionsPacketCaptureConfigUpdate() string {
+	return `
+resource junos_forwardingoptions_packet_capture testacc_packetCapture {
+  file {
+    filename       = "testacc_pcap"
+    files          = 10
+    size           = 2048
+    world_readable = true
+  }
+  maximum_capture_size = 500
+}
+`
+}
@@ -0,0 +1,655 @@
+package junos
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type samplingInstanceOptions struct {
+	disable           bool
+	name              string
+	fpc               []int
+	input             []map[string]interface{}
+	familyInetOutput  []map[string]interface{}
+	familyInet6Output []map[string]interface{}
+	familyMplsOutput  []map[string]interface{}
+}
+
+func resourceForwardingOptionsSamplingInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceForwardingOptionsSamplingInstanceCreate,
+		ReadContext:   resourceForwardingOptionsSamplingInstanceRead,
+		UpdateContext: resourceForwardingOptionsSamplingInstanceUpdate,
+		DeleteContext: resourceForwardingOptionsSamplingInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceForwardingOptionsSamplingInstanceImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateNameObjectJunos([]string{}),
+			},
+			"disable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"fpc": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(0, 19),
+				},
+			},
+			"input": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_packets_per_second": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"maximum_packet_length": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 9192),
+						},
+						"rate": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 16000000),
+						},
+						"run_length": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 20),
+						},
+					},
+				},
+			},
+			"family_inet_output": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: genSchemaForwardingOptionsSamplingInstanceOutput(),
+				},
+			},
+			"family_inet6_output": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: genSchemaForwardingOptionsSamplingInstanceOutput(),
+				},
+			},
+			"family_mpls_output": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: genSchemaForwardingOptionsSamplingInstanceOutput(),
+				},
+			},
+		},
+	}
+}
+
+func genSchemaForwardingOptionsSamplingInstanceOutput() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"flow_server": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"hostname": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsIPAddress,
+					},
+					"port": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(1, 65535),
+					},
+					"version_ipfix_template": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateDiagFunc: validateNameObjectJunos([]string{}),
+					},
+					"version9_template": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateDiagFunc: validateNameObjectJunos([]string{}),
+					},
+				},
+			},
+		},
+		"inline_jflow_source_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+	}
+}
+
+func resourceForwardingOptionsSamplingInstanceCreate(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	samplingInstanceExists, err := checkForwardingOptionsSamplingInstanceExists(d.Get("name").(string), m, jnprSess)
+	if err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if samplingInstanceExists {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(fmt.Errorf("forwarding-options sampling instance %v already exists",
+			d.Get("name").(string)))
+	}
+	if err := checkForwardingOptionsSamplingInstanceFpcFree(d, m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+
+	if err := setForwardingOptionsSamplingInstance(d, m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("create resource junos_forwardingoptions_sampling_instance", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	samplingInstanceExists, err = checkForwardingOptionsSamplingInstanceExists(d.Get("name").(string), m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if samplingInstanceExists {
+		d.SetId(d.Get("name").(string))
+	} else {
+		return diag.FromErr(fmt.Errorf("forwarding-options sampling instance %v not exists after commit "+
+			"=> check your config", d.Get("name").(string)))
+	}
+
+	return resourceForwardingOptionsSamplingInstanceRead(ctx, d, m)
+}
+func resourceForwardingOptionsSamplingInstanceRead(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	mutex.Lock()
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		mutex.Unlock()
+
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	samplingInstanceOptions, err := readForwardingOptionsSamplingInstance(d.Get("name").(string), m, jnprSess)
+	mutex.Unlock()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if samplingInstanceOptions.name == "" {
+		d.SetId("")
+	} else {
+		fillForwardingOptionsSamplingInstanceData(d, samplingInstanceOptions)
+	}
+
+	return nil
+}
+func resourceForwardingOptionsSamplingInstanceUpdate(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.Partial(true)
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	if err := checkForwardingOptionsSamplingInstanceFpcFree(d, m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := delForwardingOptionsSamplingInstance(d.Get("name").(string), m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := setForwardingOptionsSamplingInstance(d, m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("update resource junos_forwardingoptions_sampling_instance", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	d.Partial(false)
+
+	return resourceForwardingOptionsSamplingInstanceRead(ctx, d, m)
+}
+func resourceForwardingOptionsSamplingInstanceDelete(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	if err := delForwardingOptionsSamplingInstance(d.Get("name").(string), m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("delete resource junos_forwardingoptions_sampling_instance", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+func resourceForwardingOptionsSamplingInstanceImport(
+	d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer sess.closeSession(jnprSess)
+	result := make([]*schema.ResourceData, 1)
+
+	samplingInstanceExists, err := checkForwardingOptionsSamplingInstanceExists(d.Id(), m, jnprSess)
+	if err != nil {
+		return nil, err
+	}
+	if !samplingInstanceExists {
+		return nil, fmt.Errorf("don't find forwarding-options sampling instance with id '%v' "+
+			"(id must be <name>)", d.Id())
+	}
+	samplingInstanceOptions, err := readForwardingOptionsSamplingInstance(d.Id(), m, jnprSess)
+	if err != nil {
+		return nil, err
+	}
+	fillForwardingOptionsSamplingInstanceData(d, samplingInstanceOptions)
+
+	result[0] = d
+
+	return result, nil
+}
+
+func checkForwardingOptionsSamplingInstanceExists(instance string, m interface{},
+	jnprSess *NetconfObject) (bool, error) {
+	sess := m.(*Session)
+	samplingInstanceConfig, err := sess.command("show configuration"+
+		" forwarding-options sampling instance "+instance+" | display set", jnprSess)
+	if err != nil {
+		return false, err
+	}
+	if samplingInstanceConfig == emptyWord {
+		return false, nil
+	}
+
+	return true, nil
+}
+func checkForwardingOptionsSamplingInstanceFpcFree(d *schema.ResourceData, m interface{},
+	jnprSess *NetconfObject) error {
+	sess := m.(*Session)
+	for _, v := range d.Get("fpc").(*schema.Set).List() {
+		fpcConfig, err := sess.command("show configuration"+
+			" chassis fpc "+strconv.Itoa(v.(int))+" | display set relative", jnprSess)
+		if err != nil {
+			return err
+		}
+		if fpcConfig == emptyWord {
+			continue
+		}
+		for _, item := range strings.Split(fpcConfig, "\n") {
+			if strings.Contains(item, "<configuration-output>") {
+				continue
+			}
+			if strings.Contains(item, "</configuration-output>") {
+				break
+			}
+			itemTrim := strings.TrimPrefix(item, setLineStart)
+			if strings.HasPrefix(itemTrim, "sampling-instance ") &&
+				strings.TrimPrefix(itemTrim, "sampling-instance ") != d.Get("name").(string) {
+				return fmt.Errorf("chassis fpc %d already bound to sampling-instance %s",
+					v.(int), strings.TrimPrefix(itemTrim, "sampling-instance "))
+			}
+		}
+	}
+
+	return nil
+}
+func setForwardingOptionsSamplingInstance(d *schema.ResourceData, m interface{}, jnprSess *NetconfObject) error {
+	sess := m.(*Session)
+
+	setPrefix := "set forwarding-options sampling instance " + d.Get("name").(string) + " "
+	configSet := make([]string, 0)
+
+	if d.Get("disable").(bool) {
+		configSet = append(configSet, setPrefix+"disable")
+	}
+	for _, v := range d.Get("fpc").(*schema.Set).List() {
+		configSet = append(configSet, "set chassis fpc "+strconv.Itoa(v.(int))+
+			" sampling-instance "+d.Get("name").(string))
+	}
+	for _, v := range d.Get("input").([]interface{}) {
+		configSet = append(configSet, setPrefix+"input")
+		if v != nil {
+			input := v.(map[string]interface{})
+			if input["max_packets_per_second"].(int) != 0 {
+				configSet = append(configSet, setPrefix+"input max-packets-per-second "+
+					strconv.Itoa(input["max_packets_per_second"].(int)))
+			}
+			if input["maximum_packet_length"].(int) != 0 {
+				configSet = append(configSet, setPrefix+"input maximum-packet-length "+
+					strconv.Itoa(input["maximum_packet_length"].(int)))
+			}
+			if input["rate"].(int) != 0 {
+				configSet = append(configSet, setPrefix+"input rate "+strconv.Itoa(input["rate"].(int)))
+			}
+			if input["run_length"].(int) != 0 {
+				configSet = append(configSet, setPrefix+"input run-length "+strconv.Itoa(input["run_length"].(int)))
+			}
+		}
+	}
+	for _, v := range d.Get("family_inet_output").([]interface{}) {
+		configSetOutput, err := setForwardingOptionsSamplingInstanceOutput(setPrefix+"family inet output ", v)
+		if err != nil {
+			return err
+		}
+		configSet = append(configSet, configSetOutput...)
+	}
+	for _, v := range d.Get("family_inet6_output").([]interface{}) {
+		configSetOutput, err := setForwardingOptionsSamplingInstanceOutput(setPrefix+"family inet6 output ", v)
+		if err != nil {
+			return err
+		}
+		configSet = append(configSet, configSetOutput...)
+	}
+	for _, v := range d.Get("family_mpls_output").([]interface{}) {
+		configSetOutput, err := setForwardingOptionsSamplingInstanceOutput(setPrefix+"family mpls output ", v)
+		if err != nil {
+			return err
+		}
+		configSet = append(configSet, configSetOutput...)
+	}
+	if len(configSet) == 0 {
+		configSet = append(configSet, strings.TrimSuffix(setPrefix, " "))
+	}
+
+	if err := sess.configSet(configSet, jnprSess); err != nil {
+		return err
+	}
+
+	return nil
+}
+func setForwardingOptionsSamplingInstanceOutput(setPrefix string, output interface{}) ([]string, error) {
+	configSet := []string{strings.TrimSuffix(setPrefix, " ")}
+	if output == nil {
+		return configSet, nil
+	}
+	outputMap := output.(map[string]interface{})
+	flowServerList := make([]string, 0)
+	for _, v := range outputMap["flow_server"].([]interface{}) {
+		flowServer := v.(map[string]interface{})
+		if stringInSlice(flowServer["hostname"].(string), flowServerList) {
+			return configSet, fmt.Errorf("multiple flow_server blocks with the same hostname %s",
+				flowServer["hostname"].(string))
+		}
+		flowServerList = append(flowServerList, flowServer["hostname"].(string))
+		setPrefixFlowServer := setPrefix + "flow-server " + flowServer["hostname"].(string) + " "
+		configSet = append(configSet, setPrefixFlowServer+"port "+strconv.Itoa(flowServer["port"].(int)))
+		if flowServer["version_ipfix_template"].(string) != "" && flowServer["version9_template"].(string) != "" {
+			return configSet, fmt.Errorf("conflict between version_ipfix_template and version9_template "+
+				"for flow-server %s", flowServer["hostname"].(string))
+		}
+		if flowServer["version_ipfix_template"].(string) != "" {
+			configSet = append(configSet, setPrefixFlowServer+"version-ipfix template "+
+				flowServer["version_ipfix_template"].(string))
+		}
+		if flowServer["version9_template"].(string) != "" {
+			configSet = append(configSet, setPrefixFlowServer+"version9 template "+
+				flowServer["version9_template"].(string))
+		}
+	}
+	if outputMap["inline_jflow_source_address"].(string) != "" {
+		configSet = append(configSet, setPrefix+"inline-jflow source-address "+
+			outputMap["inline_jflow_source_address"].(string))
+	}
+
+	return configSet, nil
+}
+func readForwardingOptionsSamplingInstance(instance string, m interface{}, jnprSess *NetconfObject) (
+	samplingInstanceOptions, error) {
+	sess := m.(*Session)
+	var confRead samplingInstanceOptions
+
+	samplingInstanceConfig, err := sess.command("show configuration"+
+		" forwarding-options sampling instance "+instance+" | display set relative", jnprSess)
+	if err != nil {
+		return confRead, err
+	}
+	if samplingInstanceConfig != emptyWord {
+		confRead.name = instance
+		for _, item := range strings.Split(samplingInstanceConfig, "\n") {
+			if strings.Contains(item, "<configuration-output>") {
+				continue
+			}
+			if strings.Contains(item, "</configuration-output>") {
+				break
+			}
+			itemTrim := strings.TrimPrefix(item, setLineStart)
+			switch {
+			case itemTrim == "disable":
+				confRead.disable = true
+			case strings.HasPrefix(itemTrim, "input"):
+				input := map[string]interface{}{
+					"max_packets_per_second": 0,
+					"maximum_packet_length":  0,
+					"rate":                   0,
+					"run_length":             0,
+				}
+				if len(confRead.input) > 0 {
+					for k, v := range confRead.input[0] {
+						input[k] = v
+					}
+				}
+				var err error
+				switch {
+				case strings.HasPrefix(itemTrim, "input max-packets-per-second "):
+					input["max_packets_per_second"], err = strconv.Atoi(
+						strings.TrimPrefix(itemTrim, "input max-packets-per-second "))
+				case strings.HasPrefix(itemTrim, "input maximum-packet-length "):
+					input["maximum_packet_length"], err = strconv.Atoi(
+						strings.TrimPrefix(itemTrim, "input maximum-packet-length "))
+				case strings.HasPrefix(itemTrim, "input rate "):
+					input["rate"], err = strconv.Atoi(strings.TrimPrefix(itemTrim, "input rate "))
+				case strings.HasPrefix(itemTrim, "input run-length "):
+					input["run_length"], err = strconv.Atoi(strings.TrimPrefix(itemTrim, "input run-length "))
+				}
+				if err != nil {
+					return confRead, fmt.Errorf("failed to convert value from '%s' to integer : %w", itemTrim, err)
+				}
+				// override (maxItem = 1)
+				confRead.input = []map[string]interface{}{input}
+			case strings.HasPrefix(itemTrim, "family inet output"):
+				var err error
+				confRead.familyInetOutput, err = readForwardingOptionsSamplingInstanceOutput(
+					strings.TrimPrefix(itemTrim, "family inet output"), confRead.familyInetOutput)
+				if err != nil {
+					return confRead, err
+				}
+			case strings.HasPrefix(itemTrim, "family inet6 output"):
+				var err error
+				confRead.familyInet6Output, err = readForwardingOptionsSamplingInstanceOutput(
+					strings.TrimPrefix(itemTrim, "family inet6 output"), confRead.familyInet6Output)
+				if err != nil {
+					return confRead, err
+				}
+			case strings.HasPrefix(itemTrim, "family mpls output"):
+				var err error
+				confRead.familyMplsOutput, err = readForwardingOptionsSamplingInstanceOutput(
+					strings.TrimPrefix(itemTrim, "family mpls output"), confRead.familyMplsOutput)
+				if err != nil {
+					return confRead, err
+				}
+			}
+		}
+		confRead.fpc, err = readForwardingOptionsSamplingInstanceFpc(instance, m, jnprSess)
+		if err != nil {
+			return confRead, err
+		}
+	} else {
+		confRead.name = ""
+
+		return confRead, nil
+	}
+
+	return confRead, nil
+}
+func readForwardingOptionsSamplingInstanceOutput(item string,
+	confReadElement []map[string]interface{}) ([]map[string]interface{}, error) {
+	output := map[string]interface{}{
+		"flow_server":                 make([]map[string]interface{}, 0),
+		"inline_jflow_source_address": "",
+	}
+	if len(confReadElement) > 0 {
+		for k, v := range confReadElement[0] {
+			output[k] = v
+		}
+	}
+	itemTrim := strings.TrimPrefix(item, " ")
+	switch {
+	case strings.HasPrefix(itemTrim, "flow-server "):
+		flowServerSplit := strings.Split(strings.TrimPrefix(itemTrim, "flow-server "), " ")
+		flowServer := map[string]interface{}{
+			"hostname":               flowServerSplit[0],
+			"port":                   0,
+			"version_ipfix_template": "",
+			"version9_template":      "",
+		}
+		flowServerList := output["flow_server"].([]map[string]interface{})
+		flowServer, flowServerList = copyAndRemoveItemMapList("hostname", false, flowServer, flowServerList)
+		itemTrimFlowServer := strings.TrimPrefix(itemTrim, "flow-server "+flowServerSplit[0]+" ")
+		switch {
+		case strings.HasPrefix(itemTrimFlowServer, "port "):
+			var err error
+			flowServer["port"], err = strconv.Atoi(strings.TrimPrefix(itemTrimFlowServer, "port "))
+			if err != nil {
+				return confReadElement, fmt.Errorf("failed to convert value from '%s' to integer : %w", itemTrim, err)
+			}
+		case strings.HasPrefix(itemTrimFlowServer, "version-ipfix template "):
+			flowServer["version_ipfix_template"] = strings.TrimPrefix(itemTrimFlowServer, "version-ipfix template ")
+		case strings.HasPrefix(itemTrimFlowServer, "version9 template "):
+			flowServer["version9_template"] = strings.TrimPrefix(itemTrimFlowServer, "version9 template ")
+		}
+		output["flow_server"] = append(flowServerList, flowServer)
+	case strings.HasPrefix(itemTrim, "inline-jflow source-address "):
+		output["inline_jflow_source_address"] = strings.TrimPrefix(itemTrim, "inline-jflow source-address ")
+	}
+
+	// override (maxItem = 1)
+	return []map[string]interface{}{output}, nil
+}
+func readForwardingOptionsSamplingInstanceFpc(instance string, m interface{},
+	jnprSess *NetconfObject) ([]int, error) {
+	sess := m.(*Session)
+	fpcList := make([]int, 0)
+
+	chassisConfig, err := sess.command("show configuration chassis | display set relative", jnprSess)
+	if err != nil {
+		return fpcList, err
+	}
+	if chassisConfig == emptyWord {
+		return fpcList, nil
+	}
+	for _, item := range strings.Split(chassisConfig, "\n") {
+		if strings.Contains(item, "<configuration-output>") {
+			continue
+		}
+		if strings.Contains(item, "</configuration-output>") {
+			break
+		}
+		itemTrim := strings.TrimPrefix(item, setLineStart)
+		if strings.HasPrefix(itemTrim, "fpc ") && strings.HasSuffix(itemTrim, " sampling-instance "+instance) {
+			fpc, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(itemTrim, "fpc "),
+				" sampling-instance "+instance))
+			if err != nil {
+				return fpcList, fmt.Errorf("failed to convert value from '%s' to integer : %w", itemTrim, err)
+			}
+			fpcList = append(fpcList, fpc)
+		}
+	}
+
+	return fpcList, nil
+}
+
+func delForwardingOptionsSamplingInstance(instance string, m interface{}, jnprSess *NetconfObject) error {
+	sess := m.(*Session)
+	fpcList, err := readForwardingOptionsSamplingInstanceFpc(instance, m, jnprSess)
+	if err != nil {
+		return err
+	}
+	configSet := make([]string, 0, 1)
+	for _, fpc := range fpcList {
+		configSet = append(configSet, "delete chassis fpc "+strconv.Itoa(fpc)+" sampling-instance")
+	}
+	configSet = append(configSet, "delete forwarding-options sampling instance "+instance)
+	if err := sess.configSet(configSet, jnprSess); err != nil {
+		return err
+	}
+
+	return nil
+}
+func fillForwardingOptionsSamplingInstanceData(d *schema.ResourceData,
+	samplingInstanceOptions samplingInstanceOptions) {
+	if tfErr := d.Set("name", samplingInstanceOptions.name); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("disable", samplingInstanceOptions.disable); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("fpc", samplingInstanceOptions.fpc); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("input", samplingInstanceOptions.input); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("family_inet_output", samplingInstanceOptions.familyInetOutput); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("family_inet6_output", samplingInstanceOptions.familyInet6Output); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("family_mpls_output", samplingInstanceOptions.familyMplsOutput); tfErr != nil {
+		panic(tfErr)
+	}
+}
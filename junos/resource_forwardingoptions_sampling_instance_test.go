@@ -0,0 +1,120 @@
+package junos_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJunosForwardingOptionsSamplingInstance_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccJunosForwardingOptionsSamplingInstanceConfigCreate(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_forwardingoptions_sampling_instance.testacc_samplingInstance",
+							"input.#", "1"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_sampling_instance.testacc_samplingInstance",
+							"input.0.rate", "100"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_sampling_instance.testacc_samplingInstance",
+							"family_inet_output.#", "1"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_sampling_instance.testacc_samplingInstance",
+							"family_inet_output.0.flow_server.#", "1"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_sampling_instance.testacc_samplingInstance",
+							"family_inet_output.0.flow_server.0.hostname", "192.0.2.1"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_sampling_instance.testacc_samplingInstance",
+							"family_inet_output.0.flow_server.0.port", "2055"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_sampling_instance.testacc_samplingInstance",
+							"family_inet_output.0.flow_server.0.version_ipfix_template", "testacc_samplingInstance"),
+					),
+				},
+				{
+					Config: testAccJunosForwardingOptionsSamplingInstanceConfigUpdate(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_forwardingoptions_sampling_instance.testacc_samplingInstance",
+							"input.0.run_length", "2"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_sampling_instance.testacc_samplingInstance",
+							"family_inet_output.0.flow_server.#", "2"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_sampling_instance.testacc_samplingInstance",
+							"family_inet_output.0.inline_jflow_source_address", "192.0.2.3"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_sampling_instance.testacc_samplingInstance",
+							"family_inet6_output.#", "1"),
+						resource.TestCheckResourceAttr("junos_forwardingoptions_sampling_instance.testacc_samplingInstance",
+							"family_inet6_output.0.flow_server.0.version_ipfix_template", "testacc_samplingInstance6"),
+					),
+				},
+				{
+					ResourceName:      "junos_forwardingoptions_sampling_instance.testacc_samplingInstance",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	}
+}
+
+func testAccJunosForwardingOptionsSamplingInstanceConfigCreate() string {
+	return `
+resource junos_services_flowmonitoring_vipfix_template testacc_samplingInstance {
+  name = "testacc_samplingInstance"
+  type = "ipv4-template"
+}
+resource junos_forwardingoptions_sampling_instance testacc_samplingInstance {
+  name = "testacc_samplingInstance"
+  input {
+    rate = 100
+  }
+  family_inet_output {
+    flow_server {
+      hostname               = "192.0.2.1"
+      port                   = 2055
+      version_ipfix_template = junos_services_flowmonitoring_vipfix_template.testacc_samplingInstance.name
+    }
+  }
+}
+`
+}
+func testAccJunosForwardingOptionsSamplingInstanceConfigUpdate() string {
+	return `
+resource junos_services_flowmonitoring_vipfix_template testacc_samplingInstance {
+  name = "testacc_samplingInstance"
+  type = "ipv4-template"
+}
+resource junos_services_flowmonitoring_vipfix_template testacc_samplingInstance6 {
+  name = "testacc_samplingInstance6"
+  type = "ipv6-template"
+}
+resource junos_forwardingoptions_sampling_instance testacc_samplingInstance {
+  name = "testacc_samplingInstance"
+  input {
+    rate       = 100
+    run_length = 2
+  }
+  family_inet_output {
+    flow_server {
+      hostname               = "192.0.2.1"
+      port                   = 2055
+      version_ipfix_template = junos_services_flowmonitoring_vipfix_template.testacc_samplingInstance.name
+    }
+    flow_server {
+      hostname               = "192.0.2.2"
+      port                   = 2055
+      version_ipfix_template = junos_services_flowmonitoring_vipfix_template.testacc_samplingInstance.name
+    }
+    inline_jflow_source_address = "192.0.2.3"
+  }
+  family_inet6_output {
+    flow_server {
+      hostname               = "192.0.2.1"
+      port                   = 2055
+      version_ipfix_template = junos_services_flowmonitoring_vipfix_template.testacc_samplingInstance6.name
+    }
+    inline_jflow_source_address = "192.0.2.3"
+  }
+}
+`
+}
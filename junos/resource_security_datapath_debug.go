@@ -0,0 +1,673 @@
+package junos
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type datapathDebugOptions struct {
+	maximumCaptureSize int
+	actionProfile      []map[string]interface{}
+	captureFile        []map[string]interface{}
+	packetFilter       []map[string]interface{}
+	traceoptionsFile   []map[string]interface{}
+}
+
+func resourceSecurityDatapathDebug() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSecurityDatapathDebugCreate,
+		ReadContext:   resourceSecurityDatapathDebugRead,
+		UpdateContext: resourceSecurityDatapathDebugUpdate,
+		DeleteContext: resourceSecurityDatapathDebugDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceSecurityDatapathDebugImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"action_profile": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateNameObjectJunos([]string{}),
+						},
+						"event": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"jexec", "lbt", "lt-enter", "lt-leave", "mac-egress", "mac-ingress",
+											"np-egress", "np-ingress", "pot"}, false),
+									},
+									"count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 65535),
+									},
+									"packet_dump": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"packet_summary": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"trace": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"module_flow_flag": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"preserve_trace_order": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"record_pic_history": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"capture_file": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: genSchemaSecurityDatapathDebugFile(),
+				},
+			},
+			"maximum_capture_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(68, 10000),
+			},
+			"packet_filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateNameObjectJunos([]string{}),
+						},
+						"action_profile": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateNameObjectJunos([]string{}),
+						},
+						"destination_port": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"destination_prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsCIDR,
+						},
+						"interface": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source_port": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source_prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsCIDR,
+						},
+					},
+				},
+			},
+			"traceoptions_file": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: genSchemaSecurityDatapathDebugFile(),
+				},
+			},
+		},
+	}
+}
+
+func genSchemaSecurityDatapathDebugFile() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"files": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(2, 1000),
+		},
+		"size": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(10240, 1073741824),
+		},
+		"no_world_readable": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"world_readable": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+	}
+}
+
+func resourceSecurityDatapathDebugCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	if !checkCompatibilitySecurity(jnprSess) {
+		return diag.FromErr(fmt.Errorf("security datapath-debug not compatible with Junos device %s",
+			jnprSess.Platform[0].Model))
+	}
+	sess.configLock(jnprSess)
+	datapathDebugExists, err := checkSecurityDatapathDebugExists(m, jnprSess)
+	if err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if datapathDebugExists {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(fmt.Errorf("security datapath-debug already configured => use import"))
+	}
+
+	if err := setSecurityDatapathDebug(d, m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("create resource junos_security_datapath_debug", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+
+	d.SetId("datapath_debug")
+
+	return resourceSecurityDatapathDebugRead(ctx, d, m)
+}
+func resourceSecurityDatapathDebugRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	mutex.Lock()
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		mutex.Unlock()
+
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	datapathDebugExists, err := checkSecurityDatapathDebugExists(m, jnprSess)
+	if err != nil {
+		mutex.Unlock()
+
+		return diag.FromErr(err)
+	}
+	if !datapathDebugExists {
+		mutex.Unlock()
+		d.SetId("")
+
+		return nil
+	}
+	datapathDebugOptions, err := readSecurityDatapathDebug(m, jnprSess)
+	mutex.Unlock()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	fillSecurityDatapathDebugData(d, datapathDebugOptions)
+
+	return nil
+}
+func resourceSecurityDatapathDebugUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.Partial(true)
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	if err := delSecurityDatapathDebug(m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := setSecurityDatapathDebug(d, m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("update resource junos_security_datapath_debug", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	d.Partial(false)
+
+	return resourceSecurityDatapathDebugRead(ctx, d, m)
+}
+func resourceSecurityDatapathDebugDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	if err := delSecurityDatapathDebug(m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("delete resource junos_security_datapath_debug", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+func resourceSecurityDatapathDebugImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer sess.closeSession(jnprSess)
+	result := make([]*schema.ResourceData, 1)
+	datapathDebugExists, err := checkSecurityDatapathDebugExists(m, jnprSess)
+	if err != nil {
+		return nil, err
+	}
+	if !datapathDebugExists {
+		return nil, fmt.Errorf("don't find security datapath-debug configuration")
+	}
+	datapathDebugOptions, err := readSecurityDatapathDebug(m, jnprSess)
+	if err != nil {
+		return nil, err
+	}
+	fillSecurityDatapathDebugData(d, datapathDebugOptions)
+	d.SetId("datapath_debug")
+	result[0] = d
+
+	return result, nil
+}
+
+func checkSecurityDatapathDebugExists(m interface{}, jnprSess *NetconfObject) (bool, error) {
+	sess := m.(*Session)
+	datapathDebugConfig, err := sess.command("show configuration"+
+		" security datapath-debug | display set", jnprSess)
+	if err != nil {
+		return false, err
+	}
+	if datapathDebugConfig == emptyWord {
+		return false, nil
+	}
+
+	return true, nil
+}
+func setSecurityDatapathDebug(d *schema.ResourceData, m interface{}, jnprSess *NetconfObject) error {
+	sess := m.(*Session)
+
+	setPrefix := "set security datapath-debug "
+	configSet := []string{strings.TrimSuffix(setPrefix, " ")}
+
+	actionProfileNameList := make([]string, 0)
+	for _, v := range d.Get("action_profile").([]interface{}) {
+		actionProfile := v.(map[string]interface{})
+		if stringInSlice(actionProfile["name"].(string), actionProfileNameList) {
+			return fmt.Errorf("multiple action_profile blocks with the same name %s", actionProfile["name"].(string))
+		}
+		actionProfileNameList = append(actionProfileNameList, actionProfile["name"].(string))
+		setPrefixActionProfile := setPrefix + "action-profile " + actionProfile["name"].(string) + " "
+		configSet = append(configSet, strings.TrimSuffix(setPrefixActionProfile, " "))
+		eventTypeList := make([]string, 0)
+		for _, v2 := range actionProfile["event"].([]interface{}) {
+			event := v2.(map[string]interface{})
+			if stringInSlice(event["type"].(string), eventTypeList) {
+				return fmt.Errorf("multiple event blocks with the same type %s in action_profile %s",
+					event["type"].(string), actionProfile["name"].(string))
+			}
+			eventTypeList = append(eventTypeList, event["type"].(string))
+			setPrefixEvent := setPrefixActionProfile + "event " + event["type"].(string) + " "
+			configSet = append(configSet, strings.TrimSuffix(setPrefixEvent, " "))
+			if event["count"].(int) != 0 {
+				configSet = append(configSet, setPrefixEvent+"count "+strconv.Itoa(event["count"].(int)))
+			}
+			if event["packet_dump"].(bool) {
+				configSet = append(configSet, setPrefixEvent+"packet-dump")
+			}
+			if event["packet_summary"].(bool) {
+				configSet = append(configSet, setPrefixEvent+"packet-summary")
+			}
+			if event["trace"].(bool) {
+				configSet = append(configSet, setPrefixEvent+"trace")
+			}
+		}
+		for _, v2 := range actionProfile["module_flow_flag"].([]interface{}) {
+			configSet = append(configSet, setPrefixActionProfile+"module flow flag "+v2.(string))
+		}
+		if actionProfile["preserve_trace_order"].(bool) {
+			configSet = append(configSet, setPrefixActionProfile+"preserve-trace-order")
+		}
+		if actionProfile["record_pic_history"].(bool) {
+			configSet = append(configSet, setPrefixActionProfile+"record-pic-history")
+		}
+	}
+	for _, v := range d.Get("capture_file").([]interface{}) {
+		configSetFile, err := setSecurityDatapathDebugFile(setPrefix+"capture-file ", v.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		configSet = append(configSet, configSetFile...)
+	}
+	if d.Get("maximum_capture_size").(int) != 0 {
+		configSet = append(configSet, setPrefix+"maximum-capture-size "+
+			strconv.Itoa(d.Get("maximum_capture_size").(int)))
+	}
+	packetFilterNameList := make([]string, 0)
+	for _, v := range d.Get("packet_filter").([]interface{}) {
+		packetFilter := v.(map[string]interface{})
+		if stringInSlice(packetFilter["name"].(string), packetFilterNameList) {
+			return fmt.Errorf("multiple packet_filter blocks with the same name %s", packetFilter["name"].(string))
+		}
+		packetFilterNameList = append(packetFilterNameList, packetFilter["name"].(string))
+		setPrefixPacketFilter := setPrefix + "packet-filter " + packetFilter["name"].(string) + " "
+		configSet = append(configSet, strings.TrimSuffix(setPrefixPacketFilter, " "))
+		if packetFilter["action_profile"].(string) != "" {
+			configSet = append(configSet, setPrefixPacketFilter+"action-profile "+packetFilter["action_profile"].(string))
+		}
+		if packetFilter["destination_port"].(string) != "" {
+			configSet = append(configSet, setPrefixPacketFilter+"destination-port "+
+				packetFilter["destination_port"].(string))
+		}
+		if packetFilter["destination_prefix"].(string) != "" {
+			configSet = append(configSet, setPrefixPacketFilter+"destination-prefix "+
+				packetFilter["destination_prefix"].(string))
+		}
+		if packetFilter["interface"].(string) != "" {
+			configSet = append(configSet, setPrefixPacketFilter+"interface "+packetFilter["interface"].(string))
+		}
+		if packetFilter["protocol"].(string) != "" {
+			configSet = append(configSet, setPrefixPacketFilter+"protocol "+packetFilter["protocol"].(string))
+		}
+		if packetFilter["source_port"].(string) != "" {
+			configSet = append(configSet, setPrefixPacketFilter+"source-port "+packetFilter["source_port"].(string))
+		}
+		if packetFilter["source_prefix"].(string) != "" {
+			configSet = append(configSet, setPrefixPacketFilter+"source-prefix "+packetFilter["source_prefix"].(string))
+		}
+	}
+	for _, v := range d.Get("traceoptions_file").([]interface{}) {
+		configSetFile, err := setSecurityDatapathDebugFile(setPrefix+"traceoptions file ", v.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		configSet = append(configSet, configSetFile...)
+	}
+
+	if err := sess.configSet(configSet, jnprSess); err != nil {
+		return err
+	}
+
+	return nil
+}
+func setSecurityDatapathDebugFile(setPrefix string, file map[string]interface{}) ([]string, error) {
+	configSet := []string{setPrefix + "\"" + file["name"].(string) + "\""}
+	if file["files"].(int) != 0 {
+		configSet = append(configSet, setPrefix+"files "+strconv.Itoa(file["files"].(int)))
+	}
+	if file["size"].(int) != 0 {
+		configSet = append(configSet, setPrefix+"size "+strconv.Itoa(file["size"].(int)))
+	}
+	if file["world_readable"].(bool) && file["no_world_readable"].(bool) {
+		return configSet, fmt.Errorf("conflict between 'world_readable' and 'no_world_readable' for %s",
+			strings.TrimSpace(strings.TrimPrefix(setPrefix, "set security datapath-debug ")))
+	}
+	if file["world_readable"].(bool) {
+		configSet = append(configSet, setPrefix+"world-readable")
+	}
+	if file["no_world_readable"].(bool) {
+		configSet = append(configSet, setPrefix+"no-world-readable")
+	}
+
+	return configSet, nil
+}
+func readSecurityDatapathDebug(m interface{}, jnprSess *NetconfObject) (datapathDebugOptions, error) {
+	sess := m.(*Session)
+	var confRead datapathDebugOptions
+
+	datapathDebugConfig, err := sess.command("show configuration"+
+		" security datapath-debug | display set relative", jnprSess)
+	if err != nil {
+		return confRead, err
+	}
+	if datapathDebugConfig != emptyWord {
+		for _, item := range strings.Split(datapathDebugConfig, "\n") {
+			if strings.Contains(item, "<configuration-output>") {
+				continue
+			}
+			if strings.Contains(item, "</configuration-output>") {
+				break
+			}
+			itemTrim := strings.TrimPrefix(item, setLineStart)
+			switch {
+			case strings.HasPrefix(itemTrim, "action-profile "):
+				actionProfileLineCut := strings.Split(strings.TrimPrefix(itemTrim, "action-profile "), " ")
+				actionProfile := map[string]interface{}{
+					"name":                 actionProfileLineCut[0],
+					"event":                make([]map[string]interface{}, 0),
+					"module_flow_flag":     make([]string, 0),
+					"preserve_trace_order": false,
+					"record_pic_history":   false,
+				}
+				actionProfile, confRead.actionProfile = copyAndRemoveItemMapList("name", false,
+					actionProfile, confRead.actionProfile)
+				itemTrimActionProfile := strings.TrimPrefix(itemTrim, "action-profile "+actionProfileLineCut[0]+" ")
+				switch {
+				case strings.HasPrefix(itemTrimActionProfile, "event "):
+					var err error
+					actionProfile["event"], err = readSecurityDatapathDebugEvent(
+						strings.TrimPrefix(itemTrimActionProfile, "event "),
+						actionProfile["event"].([]map[string]interface{}))
+					if err != nil {
+						return confRead, err
+					}
+				case strings.HasPrefix(itemTrimActionProfile, "module flow flag "):
+					actionProfile["module_flow_flag"] = append(actionProfile["module_flow_flag"].([]string),
+						strings.TrimPrefix(itemTrimActionProfile, "module flow flag "))
+				case itemTrimActionProfile == "preserve-trace-order":
+					actionProfile["preserve_trace_order"] = true
+				case itemTrimActionProfile == "record-pic-history":
+					actionProfile["record_pic_history"] = true
+				}
+				confRead.actionProfile = append(confRead.actionProfile, actionProfile)
+			case strings.HasPrefix(itemTrim, "capture-file "):
+				var err error
+				confRead.captureFile, err = readSecurityDatapathDebugFile(strings.TrimPrefix(itemTrim, "capture-file "),
+					confRead.captureFile)
+				if err != nil {
+					return confRead, err
+				}
+			case strings.HasPrefix(itemTrim, "maximum-capture-size "):
+				var err error
+				confRead.maximumCaptureSize, err = strconv.Atoi(strings.TrimPrefix(itemTrim, "maximum-capture-size "))
+				if err != nil {
+					return confRead, fmt.Errorf("failed to convert value from '%s' to integer : %w", itemTrim, err)
+				}
+			case strings.HasPrefix(itemTrim, "packet-filter "):
+				packetFilterLineCut := strings.Split(strings.TrimPrefix(itemTrim, "packet-filter "), " ")
+				packetFilter := map[string]interface{}{
+					"name":               packetFilterLineCut[0],
+					"action_profile":     "",
+					"destination_port":   "",
+					"destination_prefix": "",
+					"interface":          "",
+					"protocol":           "",
+					"source_port":        "",
+					"source_prefix":      "",
+				}
+				packetFilter, confRead.packetFilter = copyAndRemoveItemMapList("name", false,
+					packetFilter, confRead.packetFilter)
+				itemTrimPacketFilter := strings.TrimPrefix(itemTrim, "packet-filter "+packetFilterLineCut[0]+" ")
+				switch {
+				case strings.HasPrefix(itemTrimPacketFilter, "action-profile "):
+					packetFilter["action_profile"] = strings.TrimPrefix(itemTrimPacketFilter, "action-profile ")
+				case strings.HasPrefix(itemTrimPacketFilter, "destination-port "):
+					packetFilter["destination_port"] = strings.TrimPrefix(itemTrimPacketFilter, "destination-port ")
+				case strings.HasPrefix(itemTrimPacketFilter, "destination-prefix "):
+					packetFilter["destination_prefix"] = strings.TrimPrefix(itemTrimPacketFilter, "destination-prefix ")
+				case strings.HasPrefix(itemTrimPacketFilter, "interface "):
+					packetFilter["interface"] = strings.TrimPrefix(itemTrimPacketFilter, "interface ")
+				case strings.HasPrefix(itemTrimPacketFilter, "protocol "):
+					packetFilter["protocol"] = strings.TrimPrefix(itemTrimPacketFilter, "protocol ")
+				case strings.HasPrefix(itemTrimPacketFilter, "source-port "):
+					packetFilter["source_port"] = strings.TrimPrefix(itemTrimPacketFilter, "source-port ")
+				case strings.HasPrefix(itemTrimPacketFilter, "source-prefix "):
+					packetFilter["source_prefix"] = strings.TrimPrefix(itemTrimPacketFilter, "source-prefix ")
+				}
+				confRead.packetFilter = append(confRead.packetFilter, packetFilter)
+			case strings.HasPrefix(itemTrim, "traceoptions file "):
+				var err error
+				confRead.traceoptionsFile, err = readSecurityDatapathDebugFile(
+					strings.TrimPrefix(itemTrim, "traceoptions file "), confRead.traceoptionsFile)
+				if err != nil {
+					return confRead, err
+				}
+			}
+		}
+	}
+
+	return confRead, nil
+}
+func readSecurityDatapathDebugEvent(item string,
+	confReadElement []map[string]interface{}) ([]map[string]interface{}, error) {
+	eventLineCut := strings.Split(item, " ")
+	event := map[string]interface{}{
+		"type":           eventLineCut[0],
+		"count":          0,
+		"packet_dump":    false,
+		"packet_summary": false,
+		"trace":          false,
+	}
+	event, confReadElement = copyAndRemoveItemMapList("type", false, event, confReadElement)
+	itemTrimEvent := strings.TrimPrefix(item, eventLineCut[0]+" ")
+	switch {
+	case strings.HasPrefix(itemTrimEvent, "count "):
+		var err error
+		event["count"], err = strconv.Atoi(strings.TrimPrefix(itemTrimEvent, "count "))
+		if err != nil {
+			return confReadElement, fmt.Errorf("failed to convert value from '%s' to integer : %w", item, err)
+		}
+	case itemTrimEvent == "packet-dump":
+		event["packet_dump"] = true
+	case itemTrimEvent == "packet-summary":
+		event["packet_summary"] = true
+	case itemTrimEvent == "trace":
+		event["trace"] = true
+	}
+
+	return append(confReadElement, event), nil
+}
+func readSecurityDatapathDebugFile(item string,
+	confReadElement []map[string]interface{}) ([]map[string]interface{}, error) {
+	file := map[string]interface{}{
+		"name":              "",
+		"files":             0,
+		"size":              0,
+		"no_world_readable": false,
+		"world_readable":    false,
+	}
+	if len(confReadElement) > 0 {
+		for k, v := range confReadElement[0] {
+			file[k] = v
+		}
+	}
+	switch {
+	case strings.HasPrefix(item, "files "):
+		var err error
+		file["files"], err = strconv.Atoi(strings.TrimPrefix(item, "files "))
+		if err != nil {
+			return confReadElement, fmt.Errorf("failed to convert value from '%s' to integer : %w", item, err)
+		}
+	case strings.HasPrefix(item, "size "):
+		var err error
+		file["size"], err = convertSizeWithSuffix(strings.TrimPrefix(item, "size "))
+		if err != nil {
+			return confReadElement, fmt.Errorf("failed to convert value from '%s' to integer : %w", item, err)
+		}
+	case item == "world-readable":
+		file["world_readable"] = true
+	case item == "no-world-readable":
+		file["no_world_readable"] = true
+	case strings.HasPrefix(item, "\""), !strings.Contains(item, " "):
+		// other options have a value, only name of file is alone on line
+		file["name"] = strings.Trim(item, "\"")
+	}
+
+	// override (maxItem = 1)
+	return []map[string]interface{}{file}, nil
+}
+
+func delSecurityDatapathDebug(m interface{}, jnprSess *NetconfObject) error {
+	sess := m.(*Session)
+	configSet := make([]string, 0, 1)
+	configSet = append(configSet, "delete security datapath-debug")
+	if err := sess.configSet(configSet, jnprSess); err != nil {
+		return err
+	}
+
+	return nil
+}
+func fillSecurityDatapathDebugData(d *schema.ResourceData, datapathDebugOptions datapathDebugOptions) {
+	if tfErr := d.Set("action_profile", datapathDebugOptions.actionProfile); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("capture_file", datapathDebugOptions.captureFile); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("maximum_capture_size", datapathDebugOptions.maximumCaptureSize); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("packet_filter", datapathDebugOptions.packetFilter); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("traceoptions_file", datapathDebugOptions.traceoptionsFile); tfErr != nil {
+		panic(tfErr)
+	}
+}
@@ -0,0 +1,131 @@
+package junos_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJunosSecurityDatapathDebug_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccJunosSecurityDatapathDebugConfigCreate(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_security_datapath_debug.testacc_datapathDebug",
+							"capture_file.#", "1"),
+						resource.TestCheckResourceAttr("junos_security_datapath_debug.testacc_datapathDebug",
+							"capture_file.0.name", "testacc_dpdebug.pcap"),
+						resource.TestCheckResourceAttr("junos_security_datapath_debug.testacc_datapathDebug",
+							"action_profile.#", "1"),
+						resource.TestCheckResourceAttr("junos_security_datapath_debug.testacc_datapathDebug",
+							"action_profile.0.event.#", "1"),
+						resource.TestCheckResourceAttr("junos_security_datapath_debug.testacc_datapathDebug",
+							"action_profile.0.event.0.type", "np-ingress"),
+						resource.TestCheckResourceAttr("junos_security_datapath_debug.testacc_datapathDebug",
+							"action_profile.0.event.0.packet_dump", "true"),
+						resource.TestCheckResourceAttr("junos_security_datapath_debug.testacc_datapathDebug",
+							"packet_filter.#", "1"),
+						resource.TestCheckResourceAttr("junos_security_datapath_debug.testacc_datapathDebug",
+							"packet_filter.0.source_prefix", "192.0.2.1/32"),
+					),
+				},
+				{
+					Config: testAccJunosSecurityDatapathDebugConfigUpdate(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_security_datapath_debug.testacc_datapathDebug",
+							"capture_file.0.files", "5"),
+						resource.TestCheckResourceAttr("junos_security_datapath_debug.testacc_datapathDebug",
+							"maximum_capture_size", "1500"),
+						resource.TestCheckResourceAttr("junos_security_datapath_debug.testacc_datapathDebug",
+							"action_profile.0.event.#", "2"),
+						resource.TestCheckResourceAttr("junos_security_datapath_debug.testacc_datapathDebug",
+							"action_profile.0.module_flow_flag.#", "1"),
+						resource.TestCheckResourceAttr("junos_security_datapath_debug.testacc_datapathDebug",
+							"action_profile.0.preserve_trace_order", "true"),
+						resource.TestCheckResourceAttr("junos_security_datapath_debug.testacc_datapathDebug",
+							"packet_filter.#", "2"),
+						resource.TestCheckResourceAttr("junos_security_datapath_debug.testacc_datapathDebug",
+							"packet_filter.1.protocol", "tcp"),
+						resource.TestCheckResourceAttr("junos_security_datapath_debug.testacc_datapathDebug",
+							"traceoptions_file.#", "1"),
+					),
+				},
+				{
+					ResourceName:      "junos_security_datapath_debug.testacc_datapathDebug",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	}
+}
+
+func testAccJunosSecurityDatapathDebugConfigCreate() string {
+	return `
+resource junos_security_datapath_debug testacc_datapathDebug {
+  capture_file {
+    name = "testacc_dpdebug.pcap"
+  }
+  action_profile {
+    name = "testacc_dpdebug"
+    event {
+      type        = "np-ingress"
+      packet_dump = true
+    }
+  }
+  packet_filter {
+    name           = "testacc_dpdebug"
+    action_profile = "testacc_dpdebug"
+    source_prefix  = "192.0.2.1/32"
+  }
+}
+`
+}
+func testAccJunosSecurityDatapathDebugConfigUpdate() string {
+	return `
+resource junos_security_datapath_debug testacc_datapathDebug {
+  capture_file {
+    name           = "testacc_dpdebug.pcap"
+    files          = 5
+    size           = 1048576
+    world_readable = true
+  }
+  maximum_capture_size = 1500
+  action_profile {
+    name = "testacc_dpdebug"
+    event {
+      type        = "np-ingress"
+      packet_dump = true
+      trace       = true
+    }
+    event {
+      type  = "np-egress"
+      count = 10
+      trace = true
+    }
+    module_flow_flag     = ["basic-datapath"]
+    preserve_trace_order = true
+  }
+  packet_filter {
+    name               = "testacc_dpdebug"
+    action_profile     = "testacc_dpdebug"
+    source_prefix      = "192.0.2.1/32"
+    destination_prefix = "192.0.2.2/32"
+  }
+  packet_filter {
+    name             = "testacc_dpdebug2"
+    action_profile   = "testacc_dpdebug"
+    protocol         = "tcp"
+    destination_port = "443"
+  }
+  traceoptions_file {
+    name = "testacc_dpdebug.trace"
+  }
+}
+`
+}
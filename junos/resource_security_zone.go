@@ -12,6 +12,8 @@ import (
 
 type zoneOptions struct {
 	name             string
+	screen           string
+	interfaces       []string
 	inboundServices  []string
 	inboundProtocols []string
 	addressBook      []map[string]interface{}
@@ -305,6 +307,7 @@ func readSecurityZone(zone string, m interface{}, jnprSess *NetconfObject) (zone
 	if err != nil {
 		return confRead, err
 	}
+	interfaces := make([]string, 0)
 	inboundServices := make([]string, 0)
 	inboundProtocols := make([]string, 0)
 	addressBook := make([]map[string]interface{}, 0)
@@ -320,6 +323,13 @@ func readSecurityZone(zone string, m interface{}, jnprSess *NetconfObject) (zone
 			}
 			itemTrim := strings.TrimPrefix(item, setLineStart)
 			switch {
+			case strings.HasPrefix(itemTrim, "interfaces "):
+				interFace := strings.Split(strings.TrimPrefix(itemTrim, "interfaces "), " ")[0]
+				if !stringInSlice(interFace, interfaces) {
+					interfaces = append(interfaces, interFace)
+				}
+			case strings.HasPrefix(itemTrim, "screen "):
+				confRead.screen = strings.Trim(strings.TrimPrefix(itemTrim, "screen "), "\"")
 			case strings.HasPrefix(itemTrim, "host-inbound-traffic system-services "):
 				inboundServices = append(inboundServices, strings.TrimPrefix(itemTrim,
 					"host-inbound-traffic system-services "))
@@ -357,6 +367,7 @@ func readSecurityZone(zone string, m interface{}, jnprSess *NetconfObject) (zone
 
 		return confRead, nil
 	}
+	confRead.interfaces = interfaces
 	confRead.inboundServices = inboundServices
 	confRead.inboundProtocols = inboundProtocols
 	confRead.addressBook = addressBook
@@ -0,0 +1,523 @@
+package junos
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type flowMonitoringVIPFIXTemplateOptions struct {
+	flowKeyFlowDirection bool
+	flowKeyVlanID        bool
+	nexthopLearning      bool
+	flowActiveTimeout    int
+	flowInactiveTimeout  int
+	observationDomainID  int
+	optionTemplateID     int
+	templateID           int
+	name                 string
+	typeTemplate         string
+	optionRefreshRate    []map[string]interface{}
+	templateRefreshRate  []map[string]interface{}
+}
+
+func resourceServicesFlowMonitoringVIPFIXTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServicesFlowMonitoringVIPFIXTemplateCreate,
+		ReadContext:   resourceServicesFlowMonitoringVIPFIXTemplateRead,
+		UpdateContext: resourceServicesFlowMonitoringVIPFIXTemplateUpdate,
+		DeleteContext: resourceServicesFlowMonitoringVIPFIXTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceServicesFlowMonitoringVIPFIXTemplateImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateNameObjectJunos([]string{}),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{"bridge-template", "ipv4-template", "ipv6-template",
+					"mpls-ipvx-template", "mpls-template", "vpls-template"}, false),
+			},
+			"flow_active_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(10, 600),
+			},
+			"flow_inactive_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(10, 600),
+			},
+			"flow_key_flow_direction": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"flow_key_vlan_id": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"nexthop_learning": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"observation_domain_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validation.IntBetween(0, 255),
+			},
+			"option_refresh_rate": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"packets": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 480000),
+						},
+						"seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(10, 600),
+						},
+					},
+				},
+			},
+			"option_template_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1024, 65535),
+			},
+			"template_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1024, 65535),
+			},
+			"template_refresh_rate": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"packets": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 480000),
+						},
+						"seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(10, 600),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceServicesFlowMonitoringVIPFIXTemplateCreate(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	templateExists, err := checkServicesFlowMonitoringVIPFIXTemplateExists(d.Get("name").(string), m, jnprSess)
+	if err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if templateExists {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(fmt.Errorf("services flow-monitoring version-ipfix template %v already exists",
+			d.Get("name").(string)))
+	}
+
+	if err := setServicesFlowMonitoringVIPFIXTemplate(d, m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("create resource junos_services_flowmonitoring_vipfix_template", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	templateExists, err = checkServicesFlowMonitoringVIPFIXTemplateExists(d.Get("name").(string), m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if templateExists {
+		d.SetId(d.Get("name").(string))
+	} else {
+		return diag.FromErr(fmt.Errorf("services flow-monitoring version-ipfix template %v not exists after commit "+
+			"=> check your config", d.Get("name").(string)))
+	}
+
+	return resourceServicesFlowMonitoringVIPFIXTemplateRead(ctx, d, m)
+}
+func resourceServicesFlowMonitoringVIPFIXTemplateRead(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	mutex.Lock()
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		mutex.Unlock()
+
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	templateOptions, err := readServicesFlowMonitoringVIPFIXTemplate(d.Get("name").(string), m, jnprSess)
+	mutex.Unlock()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if templateOptions.name == "" {
+		d.SetId("")
+	} else {
+		fillServicesFlowMonitoringVIPFIXTemplateData(d, templateOptions)
+	}
+
+	return nil
+}
+func resourceServicesFlowMonitoringVIPFIXTemplateUpdate(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.Partial(true)
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	if err := delServicesFlowMonitoringVIPFIXTemplate(d.Get("name").(string), m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := setServicesFlowMonitoringVIPFIXTemplate(d, m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("update resource junos_services_flowmonitoring_vipfix_template", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	d.Partial(false)
+
+	return resourceServicesFlowMonitoringVIPFIXTemplateRead(ctx, d, m)
+}
+func resourceServicesFlowMonitoringVIPFIXTemplateDelete(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	if err := delServicesFlowMonitoringVIPFIXTemplate(d.Get("name").(string), m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("delete resource junos_services_flowmonitoring_vipfix_template", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+func resourceServicesFlowMonitoringVIPFIXTemplateImport(
+	d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer sess.closeSession(jnprSess)
+	result := make([]*schema.ResourceData, 1)
+
+	templateExists, err := checkServicesFlowMonitoringVIPFIXTemplateExists(d.Id(), m, jnprSess)
+	if err != nil {
+		return nil, err
+	}
+	if !templateExists {
+		return nil, fmt.Errorf("don't find services flow-monitoring version-ipfix template with id '%v' "+
+			"(id must be <name>)", d.Id())
+	}
+	templateOptions, err := readServicesFlowMonitoringVIPFIXTemplate(d.Id(), m, jnprSess)
+	if err != nil {
+		return nil, err
+	}
+	fillServicesFlowMonitoringVIPFIXTemplateData(d, templateOptions)
+
+	result[0] = d
+
+	return result, nil
+}
+
+func checkServicesFlowMonitoringVIPFIXTemplateExists(template string, m interface{},
+	jnprSess *NetconfObject) (bool, error) {
+	sess := m.(*Session)
+	templateConfig, err := sess.command("show configuration"+
+		" services flow-monitoring version-ipfix template "+template+" | display set", jnprSess)
+	if err != nil {
+		return false, err
+	}
+	if templateConfig == emptyWord {
+		return false, nil
+	}
+
+	return true, nil
+}
+func setServicesFlowMonitoringVIPFIXTemplate(d *schema.ResourceData, m interface{}, jnprSess *NetconfObject) error {
+	sess := m.(*Session)
+
+	setPrefix := "set services flow-monitoring version-ipfix template " + d.Get("name").(string) + " "
+	configSet := make([]string, 0)
+
+	configSet = append(configSet, setPrefix+d.Get("type").(string))
+	if d.Get("flow_active_timeout").(int) != 0 {
+		configSet = append(configSet, setPrefix+"flow-active-timeout "+
+			strconv.Itoa(d.Get("flow_active_timeout").(int)))
+	}
+	if d.Get("flow_inactive_timeout").(int) != 0 {
+		configSet = append(configSet, setPrefix+"flow-inactive-timeout "+
+			strconv.Itoa(d.Get("flow_inactive_timeout").(int)))
+	}
+	if d.Get("flow_key_flow_direction").(bool) {
+		configSet = append(configSet, setPrefix+"flow-key flow-direction")
+	}
+	if d.Get("flow_key_vlan_id").(bool) {
+		configSet = append(configSet, setPrefix+"flow-key vlan-id")
+	}
+	if d.Get("nexthop_learning").(bool) {
+		configSet = append(configSet, setPrefix+"nexthop-learning enable")
+	}
+	if d.Get("observation_domain_id").(int) != -1 {
+		configSet = append(configSet, setPrefix+"observation-domain-id "+
+			strconv.Itoa(d.Get("observation_domain_id").(int)))
+	}
+	for _, v := range d.Get("option_refresh_rate").([]interface{}) {
+		configSet = append(configSet, setPrefix+"option-refresh-rate")
+		if v != nil {
+			refreshRate := v.(map[string]interface{})
+			if refreshRate["packets"].(int) != 0 {
+				configSet = append(configSet, setPrefix+"option-refresh-rate packets "+
+					strconv.Itoa(refreshRate["packets"].(int)))
+			}
+			if refreshRate["seconds"].(int) != 0 {
+				configSet = append(configSet, setPrefix+"option-refresh-rate seconds "+
+					strconv.Itoa(refreshRate["seconds"].(int)))
+			}
+		}
+	}
+	if d.Get("option_template_id").(int) != 0 {
+		configSet = append(configSet, setPrefix+"option-template-id "+
+			strconv.Itoa(d.Get("option_template_id").(int)))
+	}
+	if d.Get("template_id").(int) != 0 {
+		configSet = append(configSet, setPrefix+"template-id "+
+			strconv.Itoa(d.Get("template_id").(int)))
+	}
+	for _, v := range d.Get("template_refresh_rate").([]interface{}) {
+		configSet = append(configSet, setPrefix+"template-refresh-rate")
+		if v != nil {
+			refreshRate := v.(map[string]interface{})
+			if refreshRate["packets"].(int) != 0 {
+				configSet = append(configSet, setPrefix+"template-refresh-rate packets "+
+					strconv.Itoa(refreshRate["packets"].(int)))
+			}
+			if refreshRate["seconds"].(int) != 0 {
+				configSet = append(configSet, setPrefix+"template-refresh-rate seconds "+
+					strconv.Itoa(refreshRate["seconds"].(int)))
+			}
+		}
+	}
+
+	if err := sess.configSet(configSet, jnprSess); err != nil {
+		return err
+	}
+
+	return nil
+}
+func readServicesFlowMonitoringVIPFIXTemplate(template string, m interface{}, jnprSess *NetconfObject) (
+	flowMonitoringVIPFIXTemplateOptions, error) {
+	sess := m.(*Session)
+	var confRead flowMonitoringVIPFIXTemplateOptions
+	confRead.observationDomainID = -1 // default value
+
+	templateConfig, err := sess.command("show configuration"+
+		" services flow-monitoring version-ipfix template "+template+" | display set relative", jnprSess)
+	if err != nil {
+		return confRead, err
+	}
+	if templateConfig != emptyWord {
+		confRead.name = template
+		for _, item := range strings.Split(templateConfig, "\n") {
+			if strings.Contains(item, "<configuration-output>") {
+				continue
+			}
+			if strings.Contains(item, "</configuration-output>") {
+				break
+			}
+			itemTrim := strings.TrimPrefix(item, setLineStart)
+			switch {
+			case strings.HasSuffix(itemTrim, "-template") && !strings.Contains(itemTrim, " "):
+				confRead.typeTemplate = itemTrim
+			case strings.HasPrefix(itemTrim, "flow-active-timeout "):
+				var err error
+				confRead.flowActiveTimeout, err = strconv.Atoi(strings.TrimPrefix(itemTrim, "flow-active-timeout "))
+				if err != nil {
+					return confRead, fmt.Errorf("failed to convert value from '%s' to integer : %w", itemTrim, err)
+				}
+			case strings.HasPrefix(itemTrim, "flow-inactive-timeout "):
+				var err error
+				confRead.flowInactiveTimeout, err = strconv.Atoi(strings.TrimPrefix(itemTrim, "flow-inactive-timeout "))
+				if err != nil {
+					return confRead, fmt.Errorf("failed to convert value from '%s' to integer : %w", itemTrim, err)
+				}
+			case itemTrim == "flow-key flow-direction":
+				confRead.flowKeyFlowDirection = true
+			case itemTrim == "flow-key vlan-id":
+				confRead.flowKeyVlanID = true
+			case itemTrim == "nexthop-learning enable":
+				confRead.nexthopLearning = true
+			case strings.HasPrefix(itemTrim, "observation-domain-id "):
+				var err error
+				confRead.observationDomainID, err = strconv.Atoi(strings.TrimPrefix(itemTrim, "observation-domain-id "))
+				if err != nil {
+					return confRead, fmt.Errorf("failed to convert value from '%s' to integer : %w", itemTrim, err)
+				}
+			case strings.HasPrefix(itemTrim, "option-refresh-rate"):
+				var err error
+				confRead.optionRefreshRate, err = readServicesFlowMonitoringVIPFIXTemplateRefreshRate(
+					strings.TrimPrefix(itemTrim, "option-refresh-rate"), confRead.optionRefreshRate)
+				if err != nil {
+					return confRead, err
+				}
+			case strings.HasPrefix(itemTrim, "option-template-id "):
+				var err error
+				confRead.optionTemplateID, err = strconv.Atoi(strings.TrimPrefix(itemTrim, "option-template-id "))
+				if err != nil {
+					return confRead, fmt.Errorf("failed to convert value from '%s' to integer : %w", itemTrim, err)
+				}
+			case strings.HasPrefix(itemTrim, "template-id "):
+				var err error
+				confRead.templateID, err = strconv.Atoi(strings.TrimPrefix(itemTrim, "template-id "))
+				if err != nil {
+					return confRead, fmt.Errorf("failed to convert value from '%s' to integer : %w", itemTrim, err)
+				}
+			case strings.HasPrefix(itemTrim, "template-refresh-rate"):
+				var err error
+				confRead.templateRefreshRate, err = readServicesFlowMonitoringVIPFIXTemplateRefreshRate(
+					strings.TrimPrefix(itemTrim, "template-refresh-rate"), confRead.templateRefreshRate)
+				if err != nil {
+					return confRead, err
+				}
+			}
+		}
+	} else {
+		confRead.name = ""
+
+		return confRead, nil
+	}
+
+	return confRead, nil
+}
+func readServicesFlowMonitoringVIPFIXTemplateRefreshRate(item string,
+	confReadElement []map[string]interface{}) ([]map[string]interface{}, error) {
+	refreshRate := map[string]interface{}{
+		"packets": 0,
+		"seconds": 0,
+	}
+	if len(confReadElement) > 0 {
+		for k, v := range confReadElement[0] {
+			refreshRate[k] = v
+		}
+	}
+	var err error
+	switch {
+	case strings.HasPrefix(item, " packets "):
+		refreshRate["packets"], err = strconv.Atoi(strings.TrimPrefix(item, " packets "))
+	case strings.HasPrefix(item, " seconds "):
+		refreshRate["seconds"], err = strconv.Atoi(strings.TrimPrefix(item, " seconds "))
+	}
+	if err != nil {
+		return confReadElement, fmt.Errorf("failed to convert value from '%s' to integer : %w", item, err)
+	}
+
+	// override (maxItem = 1)
+	return []map[string]interface{}{refreshRate}, nil
+}
+
+func delServicesFlowMonitoringVIPFIXTemplate(template string, m interface{}, jnprSess *NetconfObject) error {
+	sess := m.(*Session)
+	configSet := make([]string, 0, 1)
+	configSet = append(configSet, "delete services flow-monitoring version-ipfix template "+template)
+	if err := sess.configSet(configSet, jnprSess); err != nil {
+		return err
+	}
+
+	return nil
+}
+func fillServicesFlowMonitoringVIPFIXTemplateData(d *schema.ResourceData,
+	templateOptions flowMonitoringVIPFIXTemplateOptions) {
+	if tfErr := d.Set("name", templateOptions.name); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("type", templateOptions.typeTemplate); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("flow_active_timeout", templateOptions.flowActiveTimeout); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("flow_inactive_timeout", templateOptions.flowInactiveTimeout); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("flow_key_flow_direction", templateOptions.flowKeyFlowDirection); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("flow_key_vlan_id", templateOptions.flowKeyVlanID); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("nexthop_learning", templateOptions.nexthopLearning); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("observation_domain_id", templateOptions.observationDomainID); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("option_refresh_rate", templateOptions.optionRefreshRate); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("option_template_id", templateOptions.optionTemplateID); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("template_id", templateOptions.templateID); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("template_refresh_rate", templateOptions.templateRefreshRate); tfErr != nil {
+		panic(tfErr)
+	}
+}
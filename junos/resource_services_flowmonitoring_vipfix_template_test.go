@@ -0,0 +1,84 @@
+package junos_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJunosServicesFlowMonitoringVIPFIXTemplate_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccJunosServicesFlowMonitoringVIPFIXTemplateConfigCreate(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_services_flowmonitoring_vipfix_template.testacc_flowTemplate",
+							"type", "ipv4-template"),
+						resource.TestCheckResourceAttr("junos_services_flowmonitoring_vipfix_template.testacc_flowTemplate",
+							"observation_domain_id", "-1"),
+					),
+				},
+				{
+					Config: testAccJunosServicesFlowMonitoringVIPFIXTemplateConfigUpdate(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_services_flowmonitoring_vipfix_template.testacc_flowTemplate",
+							"flow_active_timeout", "60"),
+						resource.TestCheckResourceAttr("junos_services_flowmonitoring_vipfix_template.testacc_flowTemplate",
+							"flow_inactive_timeout", "30"),
+						resource.TestCheckResourceAttr("junos_services_flowmonitoring_vipfix_template.testacc_flowTemplate",
+							"flow_key_flow_direction", "true"),
+						resource.TestCheckResourceAttr("junos_services_flowmonitoring_vipfix_template.testacc_flowTemplate",
+							"observation_domain_id", "0"),
+						resource.TestCheckResourceAttr("junos_services_flowmonitoring_vipfix_template.testacc_flowTemplate",
+							"template_refresh_rate.#", "1"),
+						resource.TestCheckResourceAttr("junos_services_flowmonitoring_vipfix_template.testacc_flowTemplate",
+							"template_refresh_rate.0.packets", "1000"),
+						resource.TestCheckResourceAttr("junos_services_flowmonitoring_vipfix_template.testacc_flowTemplate",
+							"template_refresh_rate.0.seconds", "60"),
+						resource.TestCheckResourceAttr("junos_services_flowmonitoring_vipfix_template.testacc_flowTemplate",
+							"option_refresh_rate.#", "1"),
+						resource.TestCheckResourceAttr("junos_services_flowmonitoring_vipfix_template.testacc_flowTemplate",
+							"option_refresh_rate.0.seconds", "30"),
+					),
+				},
+				{
+					ResourceName:      "junos_services_flowmonitoring_vipfix_template.testacc_flowTemplate",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	}
+}
+
+func testAccJunosServicesFlowMonitoringVIPFIXTemplateConfigCreate() string {
+	return `
+resource junos_services_flowmonitoring_vipfix_template testacc_flowTemplate {
+  name = "testacc_flowTemplate"
+  type = "ipv4-template"
+}
+`
+}
+func testAccJunosServicesFlowMonitoringVIPFIXTemplateConfigUpdate() string {
+	return `
+resource junos_services_flowmonitoring_vipfix_template testacc_flowTemplate {
+  name                    = "testacc_flowTemplate"
+  type                    = "ipv4-template"
+  flow_active_timeout     = 60
+  flow_inactive_timeout   = 30
+  flow_key_flow_direction = true
+  observation_domain_id   = 0
+  template_refresh_rate {
+    packets = 1000
+    seconds = 60
+  }
+  option_refresh_rate {
+    seconds = 30
+  }
+}
+`
+}
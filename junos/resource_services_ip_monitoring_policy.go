@@ -0,0 +1,482 @@
+package junos
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type ipMonitoringPolicyOptions struct {
+	noPreempt          bool
+	name               string
+	match              []map[string]interface{}
+	thenInterface      []map[string]interface{}
+	thenPreferredRoute []map[string]interface{}
+}
+
+func resourceServicesIPMonitoringPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServicesIPMonitoringPolicyCreate,
+		ReadContext:   resourceServicesIPMonitoringPolicyRead,
+		UpdateContext: resourceServicesIPMonitoringPolicyUpdate,
+		DeleteContext: resourceServicesIPMonitoringPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceServicesIPMonitoringPolicyImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateNameObjectJunos([]string{}),
+			},
+			"match": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rpm_probe": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateNameObjectJunos([]string{}),
+						},
+						"rpm_test": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateNameObjectJunos([]string{}),
+						},
+					},
+				},
+			},
+			"no_preempt": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"then_interface": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"disable": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"enable": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"then_preferred_route": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"route": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsCIDR,
+						},
+						"routing_instance": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          defaultWord,
+							ValidateDiagFunc: validateNameObjectJunos([]string{}),
+						},
+						"discard": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"next_hop": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsIPAddress,
+							},
+						},
+						"preferred_metric": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceServicesIPMonitoringPolicyCreate(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	if !checkCompatibilitySecurity(jnprSess) {
+		return diag.FromErr(fmt.Errorf("services ip-monitoring policy not compatible with Junos device %s",
+			jnprSess.Platform[0].Model))
+	}
+	sess.configLock(jnprSess)
+	policyExists, err := checkServicesIPMonitoringPolicyExists(d.Get("name").(string), m, jnprSess)
+	if err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if policyExists {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(fmt.Errorf("services ip-monitoring policy %v already exists", d.Get("name").(string)))
+	}
+
+	if err := setServicesIPMonitoringPolicy(d, m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("create resource junos_services_ip_monitoring_policy", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	policyExists, err = checkServicesIPMonitoringPolicyExists(d.Get("name").(string), m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if policyExists {
+		d.SetId(d.Get("name").(string))
+	} else {
+		return diag.FromErr(fmt.Errorf("services ip-monitoring policy %v not exists after commit "+
+			"=> check your config", d.Get("name").(string)))
+	}
+
+	return resourceServicesIPMonitoringPolicyRead(ctx, d, m)
+}
+func resourceServicesIPMonitoringPolicyRead(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	mutex.Lock()
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		mutex.Unlock()
+
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	policyOptions, err := readServicesIPMonitoringPolicy(d.Get("name").(string), m, jnprSess)
+	mutex.Unlock()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if policyOptions.name == "" {
+		d.SetId("")
+	} else {
+		fillServicesIPMonitoringPolicyData(d, policyOptions)
+	}
+
+	return nil
+}
+func resourceServicesIPMonitoringPolicyUpdate(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.Partial(true)
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	if err := delServicesIPMonitoringPolicy(d.Get("name").(string), m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := setServicesIPMonitoringPolicy(d, m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("update resource junos_services_ip_monitoring_policy", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	d.Partial(false)
+
+	return resourceServicesIPMonitoringPolicyRead(ctx, d, m)
+}
+func resourceServicesIPMonitoringPolicyDelete(
+	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	if err := delServicesIPMonitoringPolicy(d.Get("name").(string), m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("delete resource junos_services_ip_monitoring_policy", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+func resourceServicesIPMonitoringPolicyImport(
+	d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer sess.closeSession(jnprSess)
+	result := make([]*schema.ResourceData, 1)
+
+	policyExists, err := checkServicesIPMonitoringPolicyExists(d.Id(), m, jnprSess)
+	if err != nil {
+		return nil, err
+	}
+	if !policyExists {
+		return nil, fmt.Errorf("don't find services ip-monitoring policy with id '%v' (id must be <name>)", d.Id())
+	}
+	policyOptions, err := readServicesIPMonitoringPolicy(d.Id(), m, jnprSess)
+	if err != nil {
+		return nil, err
+	}
+	fillServicesIPMonitoringPolicyData(d, policyOptions)
+
+	result[0] = d
+
+	return result, nil
+}
+
+func checkServicesIPMonitoringPolicyExists(policy string, m interface{}, jnprSess *NetconfObject) (bool, error) {
+	sess := m.(*Session)
+	policyConfig, err := sess.command("show configuration services ip-monitoring policy "+policy+
+		" | display set", jnprSess)
+	if err != nil {
+		return false, err
+	}
+	if policyConfig == emptyWord {
+		return false, nil
+	}
+
+	return true, nil
+}
+func setServicesIPMonitoringPolicy(d *schema.ResourceData, m interface{}, jnprSess *NetconfObject) error {
+	sess := m.(*Session)
+
+	setPrefix := "set services ip-monitoring policy " + d.Get("name").(string) + " "
+	configSet := make([]string, 0)
+
+	for _, v := range d.Get("match").([]interface{}) {
+		match := v.(map[string]interface{})
+		configSet = append(configSet, setPrefix+"match rpm-probe "+match["rpm_probe"].(string)+
+			" rpm-test "+match["rpm_test"].(string))
+	}
+	if d.Get("no_preempt").(bool) {
+		configSet = append(configSet, setPrefix+"no-preempt")
+	}
+	interfaceNameList := make([]string, 0)
+	for _, v := range d.Get("then_interface").([]interface{}) {
+		thenInterface := v.(map[string]interface{})
+		if stringInSlice(thenInterface["name"].(string), interfaceNameList) {
+			return fmt.Errorf("multiple then_interface blocks with the same name %s", thenInterface["name"].(string))
+		}
+		interfaceNameList = append(interfaceNameList, thenInterface["name"].(string))
+		if thenInterface["disable"].(bool) && thenInterface["enable"].(bool) {
+			return fmt.Errorf("conflict between disable and enable for then_interface %s", thenInterface["name"].(string))
+		}
+		if !thenInterface["disable"].(bool) && !thenInterface["enable"].(bool) {
+			return fmt.Errorf("missing disable or enable for then_interface %s", thenInterface["name"].(string))
+		}
+		if thenInterface["disable"].(bool) {
+			configSet = append(configSet, setPrefix+"then interface "+thenInterface["name"].(string)+" disable")
+		}
+		if thenInterface["enable"].(bool) {
+			configSet = append(configSet, setPrefix+"then interface "+thenInterface["name"].(string)+" enable")
+		}
+	}
+	routeList := make([]string, 0)
+	for _, v := range d.Get("then_preferred_route").([]interface{}) {
+		preferredRoute := v.(map[string]interface{})
+		if stringInSlice(preferredRoute["route"].(string)+idSeparator+preferredRoute["routing_instance"].(string),
+			routeList) {
+			return fmt.Errorf("multiple then_preferred_route blocks with the same route %s and routing_instance %s",
+				preferredRoute["route"].(string), preferredRoute["routing_instance"].(string))
+		}
+		routeList = append(routeList, preferredRoute["route"].(string)+idSeparator+
+			preferredRoute["routing_instance"].(string))
+		setPrefixRoute := setPrefix + "then preferred-route "
+		if preferredRoute["routing_instance"].(string) != defaultWord {
+			setPrefixRoute += "routing-instances " + preferredRoute["routing_instance"].(string) + " "
+		}
+		setPrefixRoute += "route " + preferredRoute["route"].(string) + " "
+		configSet = append(configSet, strings.TrimSuffix(setPrefixRoute, " "))
+		if preferredRoute["discard"].(bool) {
+			if len(preferredRoute["next_hop"].([]interface{})) > 0 {
+				return fmt.Errorf("conflict between discard and next_hop for then_preferred_route %s",
+					preferredRoute["route"].(string))
+			}
+			configSet = append(configSet, setPrefixRoute+"discard")
+		}
+		for _, v2 := range preferredRoute["next_hop"].([]interface{}) {
+			configSet = append(configSet, setPrefixRoute+"next-hop "+v2.(string))
+		}
+		if preferredRoute["preferred_metric"].(int) != 0 {
+			configSet = append(configSet, setPrefixRoute+"preferred-metric "+
+				strconv.Itoa(preferredRoute["preferred_metric"].(int)))
+		}
+	}
+
+	if err := sess.configSet(configSet, jnprSess); err != nil {
+		return err
+	}
+
+	return nil
+}
+func readServicesIPMonitoringPolicy(policy string, m interface{}, jnprSess *NetconfObject) (
+	ipMonitoringPolicyOptions, error) {
+	sess := m.(*Session)
+	var confRead ipMonitoringPolicyOptions
+
+	policyConfig, err := sess.command("show configuration"+
+		" services ip-monitoring policy "+policy+" | display set relative", jnprSess)
+	if err != nil {
+		return confRead, err
+	}
+	if policyConfig != emptyWord {
+		confRead.name = policy
+		for _, item := range strings.Split(policyConfig, "\n") {
+			if strings.Contains(item, "<configuration-output>") {
+				continue
+			}
+			if strings.Contains(item, "</configuration-output>") {
+				break
+			}
+			itemTrim := strings.TrimPrefix(item, setLineStart)
+			switch {
+			case strings.HasPrefix(itemTrim, "match rpm-probe "):
+				matchLineCut := strings.Split(strings.TrimPrefix(itemTrim, "match rpm-probe "), " ")
+				if len(matchLineCut) > 2 && matchLineCut[1] == "rpm-test" {
+					confRead.match = append(confRead.match, map[string]interface{}{
+						"rpm_probe": matchLineCut[0],
+						"rpm_test":  matchLineCut[2],
+					})
+				}
+			case itemTrim == "no-preempt":
+				confRead.noPreempt = true
+			case strings.HasPrefix(itemTrim, "then interface "):
+				interfaceLineCut := strings.Split(strings.TrimPrefix(itemTrim, "then interface "), " ")
+				thenInterface := map[string]interface{}{
+					"name":    interfaceLineCut[0],
+					"disable": false,
+					"enable":  false,
+				}
+				thenInterface, confRead.thenInterface = copyAndRemoveItemMapList("name", false,
+					thenInterface, confRead.thenInterface)
+				if len(interfaceLineCut) > 1 {
+					switch interfaceLineCut[1] {
+					case "disable":
+						thenInterface["disable"] = true
+					case "enable":
+						thenInterface["enable"] = true
+					}
+				}
+				confRead.thenInterface = append(confRead.thenInterface, thenInterface)
+			case strings.HasPrefix(itemTrim, "then preferred-route "):
+				itemTrimRoute := strings.TrimPrefix(itemTrim, "then preferred-route ")
+				instance := defaultWord
+				if strings.HasPrefix(itemTrimRoute, "routing-instances ") {
+					instanceLineCut := strings.Split(strings.TrimPrefix(itemTrimRoute, "routing-instances "), " ")
+					instance = instanceLineCut[0]
+					itemTrimRoute = strings.TrimPrefix(itemTrimRoute, "routing-instances "+instance+" ")
+				}
+				if !strings.HasPrefix(itemTrimRoute, "route ") {
+					continue
+				}
+				routeLineCut := strings.Split(strings.TrimPrefix(itemTrimRoute, "route "), " ")
+				preferredRoute := map[string]interface{}{
+					"route":            routeLineCut[0],
+					"routing_instance": instance,
+					"discard":          false,
+					"next_hop":         make([]string, 0),
+					"preferred_metric": 0,
+				}
+				// search if route already in list with the same routing_instance
+				for i, v := range confRead.thenPreferredRoute {
+					if v["route"].(string) == routeLineCut[0] && v["routing_instance"].(string) == instance {
+						preferredRoute = v
+						confRead.thenPreferredRoute = append(confRead.thenPreferredRoute[:i],
+							confRead.thenPreferredRoute[i+1:]...)
+
+						break
+					}
+				}
+				itemTrimRoute = strings.TrimPrefix(itemTrimRoute, "route "+routeLineCut[0]+" ")
+				switch {
+				case itemTrimRoute == "discard":
+					preferredRoute["discard"] = true
+				case strings.HasPrefix(itemTrimRoute, "next-hop "):
+					preferredRoute["next_hop"] = append(preferredRoute["next_hop"].([]string),
+						strings.TrimPrefix(itemTrimRoute, "next-hop "))
+				case strings.HasPrefix(itemTrimRoute, "preferred-metric "):
+					var err error
+					preferredRoute["preferred_metric"], err = strconv.Atoi(strings.TrimPrefix(itemTrimRoute,
+						"preferred-metric "))
+					if err != nil {
+						return confRead, fmt.Errorf("failed to convert value from '%s' to integer : %w", itemTrim, err)
+					}
+				}
+				confRead.thenPreferredRoute = append(confRead.thenPreferredRoute, preferredRoute)
+			}
+		}
+	}
+
+	return confRead, nil
+}
+
+func delServicesIPMonitoringPolicy(policy string, m interface{}, jnprSess *NetconfObject) error {
+	sess := m.(*Session)
+	configSet := make([]string, 0, 1)
+	configSet = append(configSet, "delete services ip-monitoring policy "+policy)
+	if err := sess.configSet(configSet, jnprSess); err != nil {
+		return err
+	}
+
+	return nil
+}
+func fillServicesIPMonitoringPolicyData(d *schema.ResourceData, policyOptions ipMonitoringPolicyOptions) {
+	if tfErr := d.Set("name", policyOptions.name); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("match", policyOptions.match); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("no_preempt", policyOptions.noPreempt); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("then_interface", policyOptions.thenInterface); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("then_preferred_route", policyOptions.thenPreferredRoute); tfErr != nil {
+		panic(tfErr)
+	}
+}
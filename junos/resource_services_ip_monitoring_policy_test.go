@@ -0,0 +1,123 @@
+package junos_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJunosServicesIPMonitoringPolicy_basic(t *testing.T) {
+	var testaccInterface string
+	if os.Getenv("TESTACC_INTERFACE") != "" {
+		testaccInterface = os.Getenv("TESTACC_INTERFACE")
+	} else {
+		testaccInterface = defaultInterfaceTestAcc
+	}
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccJunosServicesIPMonitoringPolicyConfigCreate(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_services_ip_monitoring_policy.testacc_ipMonitoring",
+							"match.#", "1"),
+						resource.TestCheckResourceAttr("junos_services_ip_monitoring_policy.testacc_ipMonitoring",
+							"match.0.rpm_test", "testacc_ipMonitoring"),
+						resource.TestCheckResourceAttr("junos_services_ip_monitoring_policy.testacc_ipMonitoring",
+							"then_preferred_route.#", "1"),
+						resource.TestCheckResourceAttr("junos_services_ip_monitoring_policy.testacc_ipMonitoring",
+							"then_preferred_route.0.routing_instance", "default"),
+						resource.TestCheckResourceAttr("junos_services_ip_monitoring_policy.testacc_ipMonitoring",
+							"then_preferred_route.0.next_hop.#", "1"),
+					),
+				},
+				{
+					Config: testAccJunosServicesIPMonitoringPolicyConfigUpdate(testaccInterface),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_services_ip_monitoring_policy.testacc_ipMonitoring",
+							"no_preempt", "true"),
+						resource.TestCheckResourceAttr("junos_services_ip_monitoring_policy.testacc_ipMonitoring",
+							"then_interface.#", "1"),
+						resource.TestCheckResourceAttr("junos_services_ip_monitoring_policy.testacc_ipMonitoring",
+							"then_interface.0.disable", "true"),
+						resource.TestCheckResourceAttr("junos_services_ip_monitoring_policy.testacc_ipMonitoring",
+							"then_preferred_route.#", "2"),
+						resource.TestCheckResourceAttr("junos_services_ip_monitoring_policy.testacc_ipMonitoring",
+							"then_preferred_route.0.preferred_metric", "10"),
+						resource.TestCheckResourceAttr("junos_services_ip_monitoring_policy.testacc_ipMonitoring",
+							"then_preferred_route.1.routing_instance", "testacc_ipMonitoring"),
+					),
+				},
+				{
+					ResourceName:      "junos_services_ip_monitoring_policy.testacc_ipMonitoring",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	}
+}
+
+func testAccJunosServicesIPMonitoringPolicyConfigCreate() string {
+	return `
+resource junos_services_rpm_probe testacc_ipMonitoring {
+  name = "testacc_ipMonitoring"
+  test {
+    name         = "testacc_ipMonitoring"
+    target_type  = "address"
+    target_value = "192.0.2.1"
+  }
+}
+resource junos_services_ip_monitoring_policy testacc_ipMonitoring {
+  name = "testacc_ipMonitoring"
+  match {
+    rpm_probe = junos_services_rpm_probe.testacc_ipMonitoring.name
+    rpm_test  = "testacc_ipMonitoring"
+  }
+  then_preferred_route {
+    route    = "0.0.0.0/0"
+    next_hop = ["192.0.2.254"]
+  }
+}
+`
+}
+func testAccJunosServicesIPMonitoringPolicyConfigUpdate(interFace string) string {
+	return `
+resource junos_services_rpm_probe testacc_ipMonitoring {
+  name = "testacc_ipMonitoring"
+  test {
+    name         = "testacc_ipMonitoring"
+    target_type  = "address"
+    target_value = "192.0.2.1"
+  }
+}
+resource junos_routing_instance testacc_ipMonitoring {
+  name = "testacc_ipMonitoring"
+}
+resource junos_services_ip_monitoring_policy testacc_ipMonitoring {
+  name       = "testacc_ipMonitoring"
+  no_preempt = true
+  match {
+    rpm_probe = junos_services_rpm_probe.testacc_ipMonitoring.name
+    rpm_test  = "testacc_ipMonitoring"
+  }
+  then_interface {
+    name    = "` + interFace + `.0"
+    disable = true
+  }
+  then_preferred_route {
+    route            = "0.0.0.0/0"
+    next_hop         = ["192.0.2.254"]
+    preferred_metric = 10
+  }
+  then_preferred_route {
+    route            = "192.0.2.128/25"
+    routing_instance = junos_routing_instance.testacc_ipMonitoring.name
+    discard          = true
+  }
+}
+`
+}
@@ -0,0 +1,652 @@
+package junos
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type rpmProbeOptions struct {
+	delegateProbes bool
+	name           string
+	test           []map[string]interface{}
+}
+
+func resourceServicesRpmProbe() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServicesRpmProbeCreate,
+		ReadContext:   resourceServicesRpmProbeRead,
+		UpdateContext: resourceServicesRpmProbeUpdate,
+		DeleteContext: resourceServicesRpmProbeDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceServicesRpmProbeImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateNameObjectJunos([]string{}),
+			},
+			"delegate_probes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"test": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateNameObjectJunos([]string{}),
+						},
+						"data_fill": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"data_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65400),
+						},
+						"destination_interface": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"destination_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(7, 65535),
+						},
+						"dscp_code_points": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"hardware_timestamp": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"history_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      -1,
+							ValidateFunc: validation.IntBetween(0, 512),
+						},
+						"inet6_source_address": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsIPv6Address,
+						},
+						"moving_average_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      -1,
+							ValidateFunc: validation.IntBetween(0, 1024),
+						},
+						"one_way_hardware_timestamp": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"probe_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 15),
+						},
+						"probe_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 255),
+						},
+						"probe_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"http-get", "http-metadata-get", "icmp-ping", "icmp-ping-timestamp",
+								"icmp6-ping", "tcp-ping", "udp-ping", "udp-ping-timestamp",
+							}, false),
+						},
+						"routing_instance": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateNameObjectJunos([]string{}),
+						},
+						"source_address": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+						"target_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"address", "inet6-address", "inet6-url", "url"}, false),
+						},
+						"target_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"test_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      -1,
+							ValidateFunc: validation.IntBetween(0, 86400),
+						},
+						"thresholds": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"egress_time": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      -1,
+										ValidateFunc: validation.IntBetween(0, 60000000),
+									},
+									"ingress_time": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      -1,
+										ValidateFunc: validation.IntBetween(0, 60000000),
+									},
+									"jitter_egress": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      -1,
+										ValidateFunc: validation.IntBetween(0, 60000000),
+									},
+									"jitter_ingress": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      -1,
+										ValidateFunc: validation.IntBetween(0, 60000000),
+									},
+									"jitter_rtt": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      -1,
+										ValidateFunc: validation.IntBetween(0, 60000000),
+									},
+									"rtt": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      -1,
+										ValidateFunc: validation.IntBetween(0, 60000000),
+									},
+									"std_dev_egress": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      -1,
+										ValidateFunc: validation.IntBetween(0, 60000000),
+									},
+									"std_dev_ingress": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      -1,
+										ValidateFunc: validation.IntBetween(0, 60000000),
+									},
+									"std_dev_rtt": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      -1,
+										ValidateFunc: validation.IntBetween(0, 60000000),
+									},
+									"successive_loss": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      -1,
+										ValidateFunc: validation.IntBetween(0, 15),
+									},
+									"total_loss": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      -1,
+										ValidateFunc: validation.IntBetween(0, 15),
+									},
+								},
+							},
+						},
+						"traps": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"egress-jitter-exceeded", "egress-std-dev-exceeded", "egress-time-exceeded",
+									"ingress-jitter-exceeded", "ingress-std-dev-exceeded", "ingress-time-exceeded",
+									"jitter-exceeded", "probe-failure", "rtt-exceeded", "std-dev-exceeded",
+									"test-completion", "test-failure",
+								}, false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceServicesRpmProbeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	rpmProbeExists, err := checkServicesRpmProbeExists(d.Get("name").(string), m, jnprSess)
+	if err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if rpmProbeExists {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(fmt.Errorf("services rpm probe %v already exists", d.Get("name").(string)))
+	}
+
+	if err := setServicesRpmProbe(d, m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("create resource junos_services_rpm_probe", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	rpmProbeExists, err = checkServicesRpmProbeExists(d.Get("name").(string), m, jnprSess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if rpmProbeExists {
+		d.SetId(d.Get("name").(string))
+	} else {
+		return diag.FromErr(fmt.Errorf("services rpm probe %v not exists after commit "+
+			"=> check your config", d.Get("name").(string)))
+	}
+
+	return resourceServicesRpmProbeRead(ctx, d, m)
+}
+func resourceServicesRpmProbeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	mutex.Lock()
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		mutex.Unlock()
+
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	rpmProbeOptions, err := readServicesRpmProbe(d.Get("name").(string), m, jnprSess)
+	mutex.Unlock()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if rpmProbeOptions.name == "" {
+		d.SetId("")
+	} else {
+		fillServicesRpmProbeData(d, rpmProbeOptions)
+	}
+
+	return nil
+}
+func resourceServicesRpmProbeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.Partial(true)
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	if err := delServicesRpmProbe(d.Get("name").(string), m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := setServicesRpmProbe(d, m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("update resource junos_services_rpm_probe", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	d.Partial(false)
+
+	return resourceServicesRpmProbeRead(ctx, d, m)
+}
+func resourceServicesRpmProbeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer sess.closeSession(jnprSess)
+	sess.configLock(jnprSess)
+	if err := delServicesRpmProbe(d.Get("name").(string), m, jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+	if err := sess.commitConf("delete resource junos_services_rpm_probe", jnprSess); err != nil {
+		sess.configClear(jnprSess)
+
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+func resourceServicesRpmProbeImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	sess := m.(*Session)
+	jnprSess, err := sess.startNewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer sess.closeSession(jnprSess)
+	result := make([]*schema.ResourceData, 1)
+
+	rpmProbeExists, err := checkServicesRpmProbeExists(d.Id(), m, jnprSess)
+	if err != nil {
+		return nil, err
+	}
+	if !rpmProbeExists {
+		return nil, fmt.Errorf("don't find services rpm probe with id '%v' (id must be <name>)", d.Id())
+	}
+	rpmProbeOptions, err := readServicesRpmProbe(d.Id(), m, jnprSess)
+	if err != nil {
+		return nil, err
+	}
+	fillServicesRpmProbeData(d, rpmProbeOptions)
+
+	result[0] = d
+
+	return result, nil
+}
+
+func checkServicesRpmProbeExists(probe string, m interface{}, jnprSess *NetconfObject) (bool, error) {
+	sess := m.(*Session)
+	probeConfig, err := sess.command("show configuration services rpm probe "+probe+" | display set", jnprSess)
+	if err != nil {
+		return false, err
+	}
+	if probeConfig == emptyWord {
+		return false, nil
+	}
+
+	return true, nil
+}
+func setServicesRpmProbe(d *schema.ResourceData, m interface{}, jnprSess *NetconfObject) error {
+	sess := m.(*Session)
+
+	setPrefix := "set services rpm probe " + d.Get("name").(string) + " "
+	configSet := []string{strings.TrimSuffix(setPrefix, " ")}
+
+	if d.Get("delegate_probes").(bool) {
+		configSet = append(configSet, setPrefix+"delegate-probes")
+	}
+	testNameList := make([]string, 0)
+	for _, v := range d.Get("test").([]interface{}) {
+		test := v.(map[string]interface{})
+		if stringInSlice(test["name"].(string), testNameList) {
+			return fmt.Errorf("multiple test blocks with the same name %s", test["name"].(string))
+		}
+		testNameList = append(testNameList, test["name"].(string))
+		setPrefixTest := setPrefix + "test " + test["name"].(string) + " "
+		configSet = append(configSet, strings.TrimSuffix(setPrefixTest, " "))
+		if test["data_fill"].(string) != "" {
+			configSet = append(configSet, setPrefixTest+"data-fill "+test["data_fill"].(string))
+		}
+		if test["data_size"].(int) != 0 {
+			configSet = append(configSet, setPrefixTest+"data-size "+strconv.Itoa(test["data_size"].(int)))
+		}
+		if test["destination_interface"].(string) != "" {
+			configSet = append(configSet, setPrefixTest+"destination-interface "+test["destination_interface"].(string))
+		}
+		if test["destination_port"].(int) != 0 {
+			configSet = append(configSet, setPrefixTest+"destination-port "+strconv.Itoa(test["destination_port"].(int)))
+		}
+		if test["dscp_code_points"].(string) != "" {
+			configSet = append(configSet, setPrefixTest+"dscp-code-points "+test["dscp_code_points"].(string))
+		}
+		if test["hardware_timestamp"].(bool) {
+			configSet = append(configSet, setPrefixTest+"hardware-timestamp")
+		}
+		if test["history_size"].(int) != -1 {
+			configSet = append(configSet, setPrefixTest+"history-size "+strconv.Itoa(test["history_size"].(int)))
+		}
+		if test["inet6_source_address"].(string) != "" {
+			configSet = append(configSet, setPrefixTest+"inet6-options source-address "+
+				test["inet6_source_address"].(string))
+		}
+		if test["moving_average_size"].(int) != -1 {
+			configSet = append(configSet, setPrefixTest+"moving-average-size "+
+				strconv.Itoa(test["moving_average_size"].(int)))
+		}
+		if test["one_way_hardware_timestamp"].(bool) {
+			configSet = append(configSet, setPrefixTest+"one-way-hardware-timestamp")
+		}
+		if test["probe_count"].(int) != 0 {
+			configSet = append(configSet, setPrefixTest+"probe-count "+strconv.Itoa(test["probe_count"].(int)))
+		}
+		if test["probe_interval"].(int) != 0 {
+			configSet = append(configSet, setPrefixTest+"probe-interval "+strconv.Itoa(test["probe_interval"].(int)))
+		}
+		if test["probe_type"].(string) != "" {
+			configSet = append(configSet, setPrefixTest+"probe-type "+test["probe_type"].(string))
+		}
+		if test["routing_instance"].(string) != "" {
+			configSet = append(configSet, setPrefixTest+"routing-instance "+test["routing_instance"].(string))
+		}
+		if test["source_address"].(string) != "" {
+			configSet = append(configSet, setPrefixTest+"source-address "+test["source_address"].(string))
+		}
+		if test["target_type"].(string) != "" {
+			if test["target_value"].(string) == "" {
+				return fmt.Errorf("missing target_value with target_type in test %s", test["name"].(string))
+			}
+			configSet = append(configSet, setPrefixTest+"target "+test["target_type"].(string)+
+				" "+test["target_value"].(string))
+		} else if test["target_value"].(string) != "" {
+			return fmt.Errorf("missing target_type with target_value in test %s", test["name"].(string))
+		}
+		if test["test_interval"].(int) != -1 {
+			configSet = append(configSet, setPrefixTest+"test-interval "+strconv.Itoa(test["test_interval"].(int)))
+		}
+		for _, v2 := range test["thresholds"].([]interface{}) {
+			configSet = append(configSet, setPrefixTest+"thresholds")
+			if v2 != nil {
+				thresholds := v2.(map[string]interface{})
+				for _, k := range []string{
+					"egress_time", "ingress_time", "jitter_egress", "jitter_ingress", "jitter_rtt", "rtt",
+					"std_dev_egress", "std_dev_ingress", "std_dev_rtt", "successive_loss", "total_loss",
+				} {
+					if thresholds[k].(int) != -1 {
+						configSet = append(configSet, setPrefixTest+"thresholds "+
+							strings.ReplaceAll(k, "_", "-")+" "+strconv.Itoa(thresholds[k].(int)))
+					}
+				}
+			}
+		}
+		for _, v2 := range test["traps"].([]interface{}) {
+			configSet = append(configSet, setPrefixTest+"traps "+v2.(string))
+		}
+	}
+
+	if err := sess.configSet(configSet, jnprSess); err != nil {
+		return err
+	}
+
+	return nil
+}
+func readServicesRpmProbe(probe string, m interface{}, jnprSess *NetconfObject) (rpmProbeOptions, error) {
+	sess := m.(*Session)
+	var confRead rpmProbeOptions
+
+	probeConfig, err := sess.command("show configuration"+
+		" services rpm probe "+probe+" | display set relative", jnprSess)
+	if err != nil {
+		return confRead, err
+	}
+	if probeConfig != emptyWord {
+		confRead.name = probe
+		for _, item := range strings.Split(probeConfig, "\n") {
+			if strings.Contains(item, "<configuration-output>") {
+				continue
+			}
+			if strings.Contains(item, "</configuration-output>") {
+				break
+			}
+			itemTrim := strings.TrimPrefix(item, setLineStart)
+			switch {
+			case itemTrim == "delegate-probes":
+				confRead.delegateProbes = true
+			case strings.HasPrefix(itemTrim, "test "):
+				testLineCut := strings.Split(strings.TrimPrefix(itemTrim, "test "), " ")
+				test := genServicesRpmProbeTest(strings.Trim(testLineCut[0], "\""))
+				test, confRead.test = copyAndRemoveItemMapList("name", false, test, confRead.test)
+				itemTrimTest := strings.TrimPrefix(itemTrim, "test "+testLineCut[0]+" ")
+				if err := readServicesRpmProbeTest(itemTrimTest, test); err != nil {
+					return confRead, err
+				}
+				confRead.test = append(confRead.test, test)
+			}
+		}
+	}
+
+	return confRead, nil
+}
+func genServicesRpmProbeTest(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":                       name,
+		"data_fill":                  "",
+		"data_size":                  0,
+		"destination_interface":      "",
+		"destination_port":           0,
+		"dscp_code_points":           "",
+		"hardware_timestamp":         false,
+		"history_size":               -1,
+		"inet6_source_address":       "",
+		"moving_average_size":        -1,
+		"one_way_hardware_timestamp": false,
+		"probe_count":                0,
+		"probe_interval":             0,
+		"probe_type":                 "",
+		"routing_instance":           "",
+		"source_address":             "",
+		"target_type":                "",
+		"target_value":               "",
+		"test_interval":              -1,
+		"thresholds":                 make([]map[string]interface{}, 0),
+		"traps":                      make([]string, 0),
+	}
+}
+func readServicesRpmProbeTest(itemTrim string, test map[string]interface{}) error {
+	var err error
+	switch {
+	case strings.HasPrefix(itemTrim, "data-fill "):
+		test["data_fill"] = strings.TrimPrefix(itemTrim, "data-fill ")
+	case strings.HasPrefix(itemTrim, "data-size "):
+		test["data_size"], err = strconv.Atoi(strings.TrimPrefix(itemTrim, "data-size "))
+	case strings.HasPrefix(itemTrim, "destination-interface "):
+		test["destination_interface"] = strings.TrimPrefix(itemTrim, "destination-interface ")
+	case strings.HasPrefix(itemTrim, "destination-port "):
+		test["destination_port"], err = strconv.Atoi(strings.TrimPrefix(itemTrim, "destination-port "))
+	case strings.HasPrefix(itemTrim, "dscp-code-points "):
+		test["dscp_code_points"] = strings.TrimPrefix(itemTrim, "dscp-code-points ")
+	case itemTrim == "hardware-timestamp":
+		test["hardware_timestamp"] = true
+	case strings.HasPrefix(itemTrim, "history-size "):
+		test["history_size"], err = strconv.Atoi(strings.TrimPrefix(itemTrim, "history-size "))
+	case strings.HasPrefix(itemTrim, "inet6-options source-address "):
+		test["inet6_source_address"] = strings.TrimPrefix(itemTrim, "inet6-options source-address ")
+	case strings.HasPrefix(itemTrim, "moving-average-size "):
+		test["moving_average_size"], err = strconv.Atoi(strings.TrimPrefix(itemTrim, "moving-average-size "))
+	case itemTrim == "one-way-hardware-timestamp":
+		test["one_way_hardware_timestamp"] = true
+	case strings.HasPrefix(itemTrim, "probe-count "):
+		test["probe_count"], err = strconv.Atoi(strings.TrimPrefix(itemTrim, "probe-count "))
+	case strings.HasPrefix(itemTrim, "probe-interval "):
+		test["probe_interval"], err = strconv.Atoi(strings.TrimPrefix(itemTrim, "probe-interval "))
+	case strings.HasPrefix(itemTrim, "probe-type "):
+		test["probe_type"] = strings.TrimPrefix(itemTrim, "probe-type ")
+	case strings.HasPrefix(itemTrim, "routing-instance "):
+		test["routing_instance"] = strings.TrimPrefix(itemTrim, "routing-instance ")
+	case strings.HasPrefix(itemTrim, "source-address "):
+		test["source_address"] = strings.TrimPrefix(itemTrim, "source-address ")
+	case strings.HasPrefix(itemTrim, "target "):
+		targetLineCut := strings.SplitN(strings.TrimPrefix(itemTrim, "target "), " ", 2)
+		test["target_type"] = targetLineCut[0]
+		if len(targetLineCut) > 1 {
+			test["target_value"] = strings.Trim(targetLineCut[1], "\"")
+		}
+	case strings.HasPrefix(itemTrim, "test-interval "):
+		test["test_interval"], err = strconv.Atoi(strings.TrimPrefix(itemTrim, "test-interval "))
+	case strings.HasPrefix(itemTrim, "thresholds"):
+		if len(test["thresholds"].([]map[string]interface{})) == 0 {
+			test["thresholds"] = append(test["thresholds"].([]map[string]interface{}), map[string]interface{}{
+				"egress_time":     -1,
+				"ingress_time":    -1,
+				"jitter_egress":   -1,
+				"jitter_ingress":  -1,
+				"jitter_rtt":      -1,
+				"rtt":             -1,
+				"std_dev_egress":  -1,
+				"std_dev_ingress": -1,
+				"std_dev_rtt":     -1,
+				"successive_loss": -1,
+				"total_loss":      -1,
+			})
+		}
+		if strings.HasPrefix(itemTrim, "thresholds ") {
+			thresholds := test["thresholds"].([]map[string]interface{})[0]
+			thresholdsLineCut := strings.Split(strings.TrimPrefix(itemTrim, "thresholds "), " ")
+			if len(thresholdsLineCut) == 2 {
+				switch key := strings.ReplaceAll(thresholdsLineCut[0], "-", "_"); key {
+				case "egress_time", "ingress_time", "jitter_egress", "jitter_ingress", "jitter_rtt", "rtt",
+					"std_dev_egress", "std_dev_ingress", "std_dev_rtt", "successive_loss", "total_loss":
+					thresholds[key], err = strconv.Atoi(thresholdsLineCut[1])
+				}
+			}
+		}
+	case strings.HasPrefix(itemTrim, "traps "):
+		test["traps"] = append(test["traps"].([]string), strings.TrimPrefix(itemTrim, "traps "))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to convert value from '%s' to integer : %w", itemTrim, err)
+	}
+
+	return nil
+}
+
+func delServicesRpmProbe(probe string, m interface{}, jnprSess *NetconfObject) error {
+	sess := m.(*Session)
+	configSet := make([]string, 0, 1)
+	configSet = append(configSet, "delete services rpm probe "+probe)
+	if err := sess.configSet(configSet, jnprSess); err != nil {
+		return err
+	}
+
+	return nil
+}
+func fillServicesRpmProbeData(d *schema.ResourceData, rpmProbeOptions rpmProbeOptions) {
+	if tfErr := d.Set("name", rpmProbeOptions.name); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("delegate_probes", rpmProbeOptions.delegateProbes); tfErr != nil {
+		panic(tfErr)
+	}
+	if tfErr := d.Set("test", rpmProbeOptions.test); tfErr != nil {
+		panic(tfErr)
+	}
+}
@@ -0,0 +1,111 @@
+package junos_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJunosServicesRpmProbe_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck:  func() { testAccPreCheck(t) },
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccJunosServicesRpmProbeConfigCreate(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_services_rpm_probe.testacc_rpmProbe",
+							"test.#", "1"),
+						resource.TestCheckResourceAttr("junos_services_rpm_probe.testacc_rpmProbe",
+							"test.0.probe_type", "icmp-ping"),
+						resource.TestCheckResourceAttr("junos_services_rpm_probe.testacc_rpmProbe",
+							"test.0.target_type", "address"),
+						resource.TestCheckResourceAttr("junos_services_rpm_probe.testacc_rpmProbe",
+							"test.0.target_value", "192.0.2.1"),
+						resource.TestCheckResourceAttr("junos_services_rpm_probe.testacc_rpmProbe",
+							"test.0.thresholds.#", "1"),
+						resource.TestCheckResourceAttr("junos_services_rpm_probe.testacc_rpmProbe",
+							"test.0.thresholds.0.successive_loss", "3"),
+					),
+				},
+				{
+					Config: testAccJunosServicesRpmProbeConfigUpdate(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_services_rpm_probe.testacc_rpmProbe",
+							"delegate_probes", "true"),
+						resource.TestCheckResourceAttr("junos_services_rpm_probe.testacc_rpmProbe",
+							"test.#", "2"),
+						resource.TestCheckResourceAttr("junos_services_rpm_probe.testacc_rpmProbe",
+							"test.0.test_interval", "0"),
+						resource.TestCheckResourceAttr("junos_services_rpm_probe.testacc_rpmProbe",
+							"test.0.thresholds.0.total_loss", "0"),
+						resource.TestCheckResourceAttr("junos_services_rpm_probe.testacc_rpmProbe",
+							"test.0.traps.#", "2"),
+						resource.TestCheckResourceAttr("junos_services_rpm_probe.testacc_rpmProbe",
+							"test.1.probe_type", "tcp-ping"),
+						resource.TestCheckResourceAttr("junos_services_rpm_probe.testacc_rpmProbe",
+							"test.1.destination_port", "50000"),
+					),
+				},
+				{
+					ResourceName:      "junos_services_rpm_probe.testacc_rpmProbe",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	}
+}
+
+func testAccJunosServicesRpmProbeConfigCreate() string {
+	return `
+resource junos_services_rpm_probe testacc_rpmProbe {
+  name = "testacc_rpmProbe"
+  test {
+    name           = "testacc_rpmTest"
+    probe_type     = "icmp-ping"
+    probe_count    = 5
+    probe_interval = 2
+    target_type    = "address"
+    target_value   = "192.0.2.1"
+    test_interval  = 10
+    thresholds {
+      successive_loss = 3
+    }
+  }
+}
+`
+}
+func testAccJunosServicesRpmProbeConfigUpdate() string {
+	return `
+resource junos_services_rpm_probe testacc_rpmProbe {
+  name            = "testacc_rpmProbe"
+  delegate_probes = true
+  test {
+    name           = "testacc_rpmTest"
+    probe_type     = "icmp-ping"
+    probe_count    = 5
+    probe_interval = 2
+    target_type    = "address"
+    target_value   = "192.0.2.1"
+    test_interval  = 0
+    history_size   = 10
+    thresholds {
+      successive_loss = 3
+      total_loss      = 0
+      rtt             = 500000
+    }
+    traps = ["probe-failure", "test-failure"]
+  }
+  test {
+    name             = "testacc_rpmTest2"
+    probe_type       = "tcp-ping"
+    destination_port = 50000
+    target_type      = "address"
+    target_value     = "192.0.2.2"
+  }
+}
+`
+}
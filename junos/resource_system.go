@@ -12,7 +12,9 @@ import (
 )
 
 type systemOptions struct {
+	internetOptions                      []map[string]interface{}
 	nameServer                           []string
+	ports                                []map[string]interface{}
 	services                             []map[string]interface{}
 	syslog                               []map[string]interface{}
 	tracingDestinationOverrideSyslogHost string
@@ -28,11 +30,115 @@ func resourceSystem() *schema.Resource {
 			State: resourceSystemImport,
 		},
 		Schema: map[string]*schema.Schema{
+			"internet_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"icmpv4_rate_limit": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_size": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      -1,
+										ValidateFunc: validation.IntBetween(0, 4294967295),
+									},
+									"packet_rate": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      -1,
+										ValidateFunc: validation.IntBetween(0, 4294967295),
+									},
+								},
+							},
+						},
+						"icmpv6_rate_limit": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_size": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      -1,
+										ValidateFunc: validation.IntBetween(0, 4294967295),
+									},
+									"packet_rate": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      -1,
+										ValidateFunc: validation.IntBetween(0, 4294967295),
+									},
+								},
+							},
+						},
+						"no_path_mtu_discovery": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"no_tcp_reset": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"drop-all-tcp", "drop-tcp-with-syn-only"}, false),
+						},
+						"path_mtu_discovery": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"tcp_drop_synfin_set": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"name_server": {
 				Type:     schema.TypeList,
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"ports": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"console": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disable": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"insecure": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"log_out_on_disconnect": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"ansi", "small-xterm", "vt100", "xterm"}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"services": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -314,9 +420,92 @@ func setSystem(d *schema.ResourceData, m interface{}, jnprSess *NetconfObject) e
 	setPrefix := "set system "
 	configSet := make([]string, 0)
 
+	for _, internetOptions := range d.Get("internet_options").([]interface{}) {
+		configSetLenBeforeBlock := len(configSet)
+		if internetOptions != nil {
+			internetOptionsM := internetOptions.(map[string]interface{})
+			for _, icmpv4 := range internetOptionsM["icmpv4_rate_limit"].([]interface{}) {
+				configSet = append(configSet, setPrefix+"internet-options icmpv4-rate-limit")
+				if icmpv4 != nil {
+					icmpv4M := icmpv4.(map[string]interface{})
+					if icmpv4M["bucket_size"].(int) > -1 {
+						configSet = append(configSet, setPrefix+"internet-options icmpv4-rate-limit bucket-size "+
+							strconv.Itoa(icmpv4M["bucket_size"].(int)))
+					}
+					if icmpv4M["packet_rate"].(int) > -1 {
+						configSet = append(configSet, setPrefix+"internet-options icmpv4-rate-limit packet-rate "+
+							strconv.Itoa(icmpv4M["packet_rate"].(int)))
+					}
+				}
+			}
+			for _, icmpv6 := range internetOptionsM["icmpv6_rate_limit"].([]interface{}) {
+				configSet = append(configSet, setPrefix+"internet-options icmpv6-rate-limit")
+				if icmpv6 != nil {
+					icmpv6M := icmpv6.(map[string]interface{})
+					if icmpv6M["bucket_size"].(int) > -1 {
+						configSet = append(configSet, setPrefix+"internet-options icmpv6-rate-limit bucket-size "+
+							strconv.Itoa(icmpv6M["bucket_size"].(int)))
+					}
+					if icmpv6M["packet_rate"].(int) > -1 {
+						configSet = append(configSet, setPrefix+"internet-options icmpv6-rate-limit packet-rate "+
+							strconv.Itoa(icmpv6M["packet_rate"].(int)))
+					}
+				}
+			}
+			if internetOptionsM["no_path_mtu_discovery"].(bool) && internetOptionsM["path_mtu_discovery"].(bool) {
+				return fmt.Errorf("conflict between 'no_path_mtu_discovery' and 'path_mtu_discovery' for internet_options")
+			}
+			if internetOptionsM["no_path_mtu_discovery"].(bool) {
+				configSet = append(configSet, setPrefix+"internet-options no-path-mtu-discovery")
+			}
+			if internetOptionsM["no_tcp_reset"].(string) != "" {
+				configSet = append(configSet, setPrefix+"internet-options no-tcp-reset "+
+					internetOptionsM["no_tcp_reset"].(string))
+			}
+			if internetOptionsM["path_mtu_discovery"].(bool) {
+				configSet = append(configSet, setPrefix+"internet-options path-mtu-discovery")
+			}
+			if internetOptionsM["tcp_drop_synfin_set"].(bool) {
+				configSet = append(configSet, setPrefix+"internet-options tcp-drop-synfin-set")
+			}
+		}
+		if len(configSet) == configSetLenBeforeBlock {
+			return fmt.Errorf("internet_options block is empty")
+		}
+	}
 	for _, nameServer := range d.Get("name_server").([]interface{}) {
 		configSet = append(configSet, setPrefix+"name-server "+nameServer.(string))
 	}
+	for _, ports := range d.Get("ports").([]interface{}) {
+		if ports == nil {
+			return fmt.Errorf("ports block is empty")
+		}
+		portsM := ports.(map[string]interface{})
+		if len(portsM["console"].([]interface{})) == 0 {
+			return fmt.Errorf("ports block is empty")
+		}
+		for _, console := range portsM["console"].([]interface{}) {
+			configSetLenBeforeBlock := len(configSet)
+			if console != nil {
+				consoleM := console.(map[string]interface{})
+				if consoleM["disable"].(bool) {
+					configSet = append(configSet, setPrefix+"ports console disable")
+				}
+				if consoleM["insecure"].(bool) {
+					configSet = append(configSet, setPrefix+"ports console insecure")
+				}
+				if consoleM["log_out_on_disconnect"].(bool) {
+					configSet = append(configSet, setPrefix+"ports console log-out-on-disconnect")
+				}
+				if consoleM["type"].(string) != "" {
+					configSet = append(configSet, setPrefix+"ports console type "+consoleM["type"].(string))
+				}
+			}
+			if len(configSet) == configSetLenBeforeBlock {
+				return fmt.Errorf("console block in ports is empty")
+			}
+		}
+	}
 	if err := setSystemServices(d, m, jnprSess); err != nil {
 		return err
 	}
@@ -467,6 +656,24 @@ func setSystemServices(d *schema.ResourceData, m interface{}, jnprSess *NetconfO
 	return nil
 }
 
+func listLinesInternetOptions() []string {
+	return []string{
+		"internet-options icmpv4-rate-limit",
+		"internet-options icmpv6-rate-limit",
+		"internet-options no-path-mtu-discovery",
+		"internet-options no-tcp-reset",
+		"internet-options path-mtu-discovery",
+		"internet-options tcp-drop-synfin-set",
+	}
+}
+func listLinesPortsConsole() []string {
+	return []string{
+		"ports console disable",
+		"ports console insecure",
+		"ports console log-out-on-disconnect",
+		"ports console type",
+	}
+}
 func listLinesServices() []string {
 	ls := make([]string, 0)
 	ls = append(ls, listLinesServicesSSH()...)
@@ -506,7 +713,9 @@ func listLinesSyslog() []string {
 }
 func delSystem(m interface{}, jnprSess *NetconfObject) error {
 	listLinesToDelete := make([]string, 0)
+	listLinesToDelete = append(listLinesToDelete, listLinesInternetOptions()...)
 	listLinesToDelete = append(listLinesToDelete, "name-server")
+	listLinesToDelete = append(listLinesToDelete, listLinesPortsConsole()...)
 	listLinesToDelete = append(listLinesToDelete, listLinesServices()...)
 	listLinesToDelete = append(listLinesToDelete, listLinesSyslog()...)
 	listLinesToDelete = append(listLinesToDelete,
@@ -544,8 +753,105 @@ func readSystem(m interface{}, jnprSess *NetconfObject) (systemOptions, error) {
 			}
 			itemTrim := strings.TrimPrefix(item, setLineStart)
 			switch {
+			case checkStringHasPrefixInList(itemTrim, listLinesInternetOptions()):
+				if len(confRead.internetOptions) == 0 {
+					confRead.internetOptions = append(confRead.internetOptions, map[string]interface{}{
+						"icmpv4_rate_limit":     make([]map[string]interface{}, 0),
+						"icmpv6_rate_limit":     make([]map[string]interface{}, 0),
+						"no_path_mtu_discovery": false,
+						"no_tcp_reset":          "",
+						"path_mtu_discovery":    false,
+						"tcp_drop_synfin_set":   false,
+					})
+				}
+				switch {
+				case strings.HasPrefix(itemTrim, "internet-options icmpv4-rate-limit"):
+					if len(confRead.internetOptions[0]["icmpv4_rate_limit"].([]map[string]interface{})) == 0 {
+						confRead.internetOptions[0]["icmpv4_rate_limit"] = append(
+							confRead.internetOptions[0]["icmpv4_rate_limit"].([]map[string]interface{}),
+							map[string]interface{}{
+								"bucket_size": -1,
+								"packet_rate": -1,
+							})
+					}
+					switch {
+					case strings.HasPrefix(itemTrim, "internet-options icmpv4-rate-limit bucket-size "):
+						var err error
+						confRead.internetOptions[0]["icmpv4_rate_limit"].([]map[string]interface{})[0]["bucket_size"], err =
+							strconv.Atoi(strings.TrimPrefix(itemTrim, "internet-options icmpv4-rate-limit bucket-size "))
+						if err != nil {
+							return confRead, fmt.Errorf("failed to convert value from '%s' to integer : %w", itemTrim, err)
+						}
+					case strings.HasPrefix(itemTrim, "internet-options icmpv4-rate-limit packet-rate "):
+						var err error
+						confRead.internetOptions[0]["icmpv4_rate_limit"].([]map[string]interface{})[0]["packet_rate"], err =
+							strconv.Atoi(strings.TrimPrefix(itemTrim, "internet-options icmpv4-rate-limit packet-rate "))
+						if err != nil {
+							return confRead, fmt.Errorf("failed to convert value from '%s' to integer : %w", itemTrim, err)
+						}
+					}
+				case strings.HasPrefix(itemTrim, "internet-options icmpv6-rate-limit"):
+					if len(confRead.internetOptions[0]["icmpv6_rate_limit"].([]map[string]interface{})) == 0 {
+						confRead.internetOptions[0]["icmpv6_rate_limit"] = append(
+							confRead.internetOptions[0]["icmpv6_rate_limit"].([]map[string]interface{}),
+							map[string]interface{}{
+								"bucket_size": -1,
+								"packet_rate": -1,
+							})
+					}
+					switch {
+					case strings.HasPrefix(itemTrim, "internet-options icmpv6-rate-limit bucket-size "):
+						var err error
+						confRead.internetOptions[0]["icmpv6_rate_limit"].([]map[string]interface{})[0]["bucket_size"], err =
+							strconv.Atoi(strings.TrimPrefix(itemTrim, "internet-options icmpv6-rate-limit bucket-size "))
+						if err != nil {
+							return confRead, fmt.Errorf("failed to convert value from '%s' to integer : %w", itemTrim, err)
+						}
+					case strings.HasPrefix(itemTrim, "internet-options icmpv6-rate-limit packet-rate "):
+						var err error
+						confRead.internetOptions[0]["icmpv6_rate_limit"].([]map[string]interface{})[0]["packet_rate"], err =
+							strconv.Atoi(strings.TrimPrefix(itemTrim, "internet-options icmpv6-rate-limit packet-rate "))
+						if err != nil {
+							return confRead, fmt.Errorf("failed to convert value from '%s' to integer : %w", itemTrim, err)
+						}
+					}
+				case itemTrim == "internet-options no-path-mtu-discovery":
+					confRead.internetOptions[0]["no_path_mtu_discovery"] = true
+				case strings.HasPrefix(itemTrim, "internet-options no-tcp-reset "):
+					confRead.internetOptions[0]["no_tcp_reset"] = strings.TrimPrefix(itemTrim, "internet-options no-tcp-reset ")
+				case itemTrim == "internet-options path-mtu-discovery":
+					confRead.internetOptions[0]["path_mtu_discovery"] = true
+				case itemTrim == "internet-options tcp-drop-synfin-set":
+					confRead.internetOptions[0]["tcp_drop_synfin_set"] = true
+				}
 			case strings.HasPrefix(itemTrim, "name-server "):
 				confRead.nameServer = append(confRead.nameServer, strings.TrimPrefix(itemTrim, "name-server "))
+			case checkStringHasPrefixInList(itemTrim, listLinesPortsConsole()):
+				if len(confRead.ports) == 0 {
+					confRead.ports = append(confRead.ports, map[string]interface{}{
+						"console": make([]map[string]interface{}, 0),
+					})
+				}
+				if len(confRead.ports[0]["console"].([]map[string]interface{})) == 0 {
+					confRead.ports[0]["console"] = append(confRead.ports[0]["console"].([]map[string]interface{}),
+						map[string]interface{}{
+							"disable":               false,
+							"insecure":              false,
+							"log_out_on_disconnect": false,
+							"type":                  "",
+						})
+				}
+				switch {
+				case itemTrim == "ports console disable":
+					confRead.ports[0]["console"].([]map[string]interface{})[0]["disable"] = true
+				case itemTrim == "ports console insecure":
+					confRead.ports[0]["console"].([]map[string]interface{})[0]["insecure"] = true
+				case itemTrim == "ports console log-out-on-disconnect":
+					confRead.ports[0]["console"].([]map[string]interface{})[0]["log_out_on_disconnect"] = true
+				case strings.HasPrefix(itemTrim, "ports console type "):
+					confRead.ports[0]["console"].([]map[string]interface{})[0]["type"] =
+						strings.TrimPrefix(itemTrim, "ports console type ")
+				}
 			case checkStringHasPrefixInList(itemTrim, listLinesServices()):
 				if len(confRead.services) == 0 {
 					confRead.services = append(confRead.services, map[string]interface{}{
@@ -737,9 +1043,15 @@ func readSystem(m interface{}, jnprSess *NetconfObject) (systemOptions, error) {
 }
 
 func fillSystem(d *schema.ResourceData, systemOptions systemOptions) {
+	if tfErr := d.Set("internet_options", systemOptions.internetOptions); tfErr != nil {
+		panic(tfErr)
+	}
 	if tfErr := d.Set("name_server", systemOptions.nameServer); tfErr != nil {
 		panic(tfErr)
 	}
+	if tfErr := d.Set("ports", systemOptions.ports); tfErr != nil {
+		panic(tfErr)
+	}
 	if tfErr := d.Set("services", systemOptions.services); tfErr != nil {
 		panic(tfErr)
 	}
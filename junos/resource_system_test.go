@@ -16,12 +16,34 @@ func TestAccJunosSystem_basic(t *testing.T) {
 				{
 					Config: testAccJunosSystemConfigCreate(),
 					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_system.testacc_system",
+							"internet_options.#", "1"),
+						resource.TestCheckResourceAttr("junos_system.testacc_system",
+							"internet_options.0.icmpv4_rate_limit.#", "1"),
+						resource.TestCheckResourceAttr("junos_system.testacc_system",
+							"internet_options.0.icmpv4_rate_limit.0.bucket_size", "10"),
+						resource.TestCheckResourceAttr("junos_system.testacc_system",
+							"internet_options.0.icmpv6_rate_limit.0.packet_rate", "1000"),
+						resource.TestCheckResourceAttr("junos_system.testacc_system",
+							"internet_options.0.no_tcp_reset", "drop-all-tcp"),
+						resource.TestCheckResourceAttr("junos_system.testacc_system",
+							"internet_options.0.path_mtu_discovery", "true"),
+						resource.TestCheckResourceAttr("junos_system.testacc_system",
+							"internet_options.0.tcp_drop_synfin_set", "true"),
 						resource.TestCheckResourceAttr("junos_system.testacc_system",
 							"name_server.#", "2"),
 						resource.TestCheckResourceAttr("junos_system.testacc_system",
 							"name_server.0", "192.0.2.10"),
 						resource.TestCheckResourceAttr("junos_system.testacc_system",
 							"name_server.1", "192.0.2.11"),
+						resource.TestCheckResourceAttr("junos_system.testacc_system",
+							"ports.#", "1"),
+						resource.TestCheckResourceAttr("junos_system.testacc_system",
+							"ports.0.console.#", "1"),
+						resource.TestCheckResourceAttr("junos_system.testacc_system",
+							"ports.0.console.0.insecure", "true"),
+						resource.TestCheckResourceAttr("junos_system.testacc_system",
+							"ports.0.console.0.log_out_on_disconnect", "true"),
 						resource.TestCheckResourceAttr("junos_system.testacc_system",
 							"services.#", "1"),
 						resource.TestCheckResourceAttr("junos_system.testacc_system",
@@ -100,6 +122,12 @@ func TestAccJunosSystem_basic(t *testing.T) {
 				{
 					Config: testAccJunosSystemConfigUpdate(),
 					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("junos_system.testacc_system",
+							"internet_options.0.no_path_mtu_discovery", "true"),
+						resource.TestCheckResourceAttr("junos_system.testacc_system",
+							"internet_options.0.no_tcp_reset", "drop-tcp-with-syn-only"),
+						resource.TestCheckResourceAttr("junos_system.testacc_system",
+							"ports.0.console.0.type", "vt100"),
 						resource.TestCheckResourceAttr("junos_system.testacc_system",
 							"services.#", "1"),
 						resource.TestCheckResourceAttr("junos_system.testacc_system",
@@ -126,7 +154,26 @@ func TestAccJunosSystem_basic(t *testing.T) {
 func testAccJunosSystemConfigCreate() string {
 	return `
 resource junos_system "testacc_system" {
+  internet_options {
+    icmpv4_rate_limit {
+      bucket_size = 10
+      packet_rate = 1000
+    }
+    icmpv6_rate_limit {
+      bucket_size = 10
+      packet_rate = 1000
+    }
+    no_tcp_reset        = "drop-all-tcp"
+    path_mtu_discovery  = true
+    tcp_drop_synfin_set = true
+  }
   name_server = ["192.0.2.10","192.0.2.11"]
+  ports {
+    console {
+      insecure              = true
+      log_out_on_disconnect = true
+    }
+  }
   services {
     ssh {
 	  authentication_order           = ["password"]
@@ -164,7 +211,16 @@ resource junos_system "testacc_system" {
 func testAccJunosSystemConfigUpdate() string {
 	return `
 resource junos_system "testacc_system" {
+  internet_options {
+    no_path_mtu_discovery = true
+    no_tcp_reset          = "drop-tcp-with-syn-only"
+  }
   name_server = ["192.0.2.10"]
+  ports {
+    console {
+      type = "vt100"
+    }
+  }
   services {
     ssh {
        ciphers                = ["aes256-ctr"]